@@ -7,17 +7,19 @@ import (
 )
 
 type apiClient struct {
-	category           kickcontracts.Category
-	channel            kickcontracts.Channel
-	channelReward      kickcontracts.ChannelReward
-	chat               kickcontracts.Chat
-	eventsSubscription kickcontracts.EventsSubscription
-	kicks              kickcontracts.Kicks
-	livestream         kickcontracts.Livestream
-	moderation         kickcontracts.Moderation
-	publicKey          kickcontracts.PublicKey
-	user               kickcontracts.User
-	requester          *transport.Requester
+	category                 kickcontracts.Category
+	channel                  kickcontracts.Channel
+	channelReward            kickcontracts.ChannelReward
+	chat                     kickcontracts.Chat
+	eventsSubscription       kickcontracts.EventsSubscription
+	kicks                    kickcontracts.Kicks
+	livestream               kickcontracts.Livestream
+	moderation               kickcontracts.Moderation
+	publicKey                kickcontracts.PublicKey
+	user                     kickcontracts.User
+	requester                *transport.Requester
+	batchConcurrency         int
+	strictEventSubscriptions bool
 }
 
 // NewAPIClient creates a new APIClient instance with the provided configuration.
@@ -31,13 +33,21 @@ type apiClient struct {
 //		log.Fatalf("could not create APIClient: %v", err)
 //	}
 func NewAPIClient(clientConfig kickapitypes.APIClientConfig) (*apiClient, error) {
-	requester, err := transport.NewRequester(clientConfig.HTTPClient)
+	requester, err := transport.NewRequester(
+		clientConfig.HTTPClient,
+		transport.WithLogger(clientConfig.Logger),
+		transport.WithTracer(clientConfig.Tracer),
+		transport.WithMetrics(clientConfig.Metrics),
+		transport.WithInterceptors(clientConfig.Interceptors...),
+	)
 	if err != nil {
 		return nil, err
 	}
 
 	client := &apiClient{
-		requester: requester,
+		requester:                requester,
+		batchConcurrency:         clientConfig.BatchConcurrency,
+		strictEventSubscriptions: clientConfig.StrictEventSubscriptions,
 	}
 
 	client.category = newCategoryService(client)
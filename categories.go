@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/url"
 
+	"github.com/henrikah/kick-go-sdk/v2/internal/batch"
 	"github.com/henrikah/kick-go-sdk/v2/internal/endpoints"
 	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
 	"github.com/henrikah/kick-go-sdk/v2/kickcontracts"
@@ -21,7 +22,10 @@ func newCategoryService(client *apiClient) kickcontracts.Category {
 		client: client,
 	}
 }
+
 func (c *categoryClient) SearchCategories(ctx context.Context, accessToken string, filters kickfilters.CategoriesFilter) (*kickapitypes.GetCategoriesResponse, error) {
+	ctx = c.client.requester.WithOperation(ctx, "Category.SearchCategories")
+
 	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
 		return nil, err
 	}
@@ -48,3 +52,52 @@ func (c *categoryClient) SearchCategories(ctx context.Context, accessToken strin
 
 	return &categoriesData, nil
 }
+
+func (c *categoryClient) GetCategoryByID(ctx context.Context, accessToken string, categoryID int) (*kickapitypes.GetCategoryResponse, error) {
+	ctx = c.client.requester.WithOperation(ctx, "Category.GetCategoryByID")
+
+	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
+		return nil, err
+	}
+	if err := kickerrors.ValidateCategoryID(categoryID); err != nil {
+		return nil, err
+	}
+
+	var categoryData kickapitypes.GetCategoryResponse
+
+	if err := c.client.requester.MakeGetRequest(ctx, endpoints.ViewCategoryDetailsURL(categoryID), &accessToken, &categoryData); err != nil {
+		return nil, err
+	}
+
+	return &categoryData, nil
+}
+
+func (c *categoryClient) GetCategoriesByID(ctx context.Context, accessToken string, categoryIDs []int) (*kickapitypes.GetCategoriesByIDResponse, error) {
+	ctx = c.client.requester.WithOperation(ctx, "Category.GetCategoriesByID")
+
+	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
+		return nil, err
+	}
+	if err := kickerrors.ValidateMinItems("categoryIDs", categoryIDs, 1); err != nil {
+		return nil, err
+	}
+	for _, categoryID := range categoryIDs {
+		if err := kickerrors.ValidateCategoryID(categoryID); err != nil {
+			return nil, err
+		}
+	}
+
+	results, err := batch.Run(ctx, categoryIDs, 1, c.client.batchConcurrency, func(ctx context.Context, chunk []int) (*kickapitypes.GetCategoryResponse, error) {
+		categoryData, err := c.GetCategoryByID(ctx, accessToken, chunk[0])
+		if apiErr := kickerrors.IsAPIError(err); apiErr != nil && apiErr.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return categoryData, err
+	})
+
+	message, data := batch.Merge(results, func(result *kickapitypes.GetCategoryResponse) (string, []kickapitypes.Category) {
+		return result.Message, []kickapitypes.Category{result.Data}
+	})
+
+	return &kickapitypes.GetCategoriesByIDResponse{Message: message, Data: data}, err
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/url"
 
+	"github.com/henrikah/kick-go-sdk/v2/internal/batch"
 	"github.com/henrikah/kick-go-sdk/v2/internal/endpoints"
 	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
 	"github.com/henrikah/kick-go-sdk/v2/kickcontracts"
@@ -23,6 +24,8 @@ func newChannelRewardService(client *apiClient) kickcontracts.ChannelReward {
 }
 
 func (c *channelRewardClient) GetChannelRewards(ctx context.Context, accessToken string) (*kickapitypes.ChannelRewards, error) {
+	ctx = c.client.requester.WithOperation(ctx, "ChannelReward.GetChannelRewards")
+
 	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
 		return nil, err
 	}
@@ -37,6 +40,8 @@ func (c *channelRewardClient) GetChannelRewards(ctx context.Context, accessToken
 }
 
 func (c *channelRewardClient) CreateChannelReward(ctx context.Context, accessToken string, channelRewardData kickapitypes.CreateChannelReward) (*kickapitypes.ChannelReward, error) {
+	ctx = c.client.requester.WithOperation(ctx, "ChannelReward.CreateChannelReward")
+
 	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
 		return nil, err
 	}
@@ -65,6 +70,8 @@ func (c *channelRewardClient) CreateChannelReward(ctx context.Context, accessTok
 }
 
 func (c *channelRewardClient) DeleteChannelReward(ctx context.Context, accessToken string, rewardID string) error {
+	ctx = c.client.requester.WithOperation(ctx, "ChannelReward.DeleteChannelReward")
+
 	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
 		return err
 	}
@@ -81,6 +88,8 @@ func (c *channelRewardClient) DeleteChannelReward(ctx context.Context, accessTok
 }
 
 func (c *channelRewardClient) UpdateChannelReward(ctx context.Context, accessToken string, channelRewardID string, channelRewardData kickapitypes.UpdateChannelReward) (*kickapitypes.ChannelReward, error) {
+	ctx = c.client.requester.WithOperation(ctx, "ChannelReward.UpdateChannelReward")
+
 	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
 		return nil, err
 	}
@@ -113,6 +122,8 @@ func (c *channelRewardClient) UpdateChannelReward(ctx context.Context, accessTok
 }
 
 func (c *channelRewardClient) GetChannelRewardRedemptions(ctx context.Context, accessToken string, filters kickfilters.RewardRedemptionsFilter) (*kickapitypes.ChannelRewardRedemptions, error) {
+	ctx = c.client.requester.WithOperation(ctx, "ChannelReward.GetChannelRewardRedemptions")
+
 	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
 		return nil, err
 	}
@@ -141,6 +152,8 @@ func (c *channelRewardClient) GetChannelRewardRedemptions(ctx context.Context, a
 }
 
 func (c *channelRewardClient) AcceptRewardRedemption(ctx context.Context, accessToken string, redemptionIDs []string) (*kickapitypes.RedemptionDecision, error) {
+	ctx = c.client.requester.WithOperation(ctx, "ChannelReward.AcceptRewardRedemption")
+
 	acceptRewardRedemptionURL, err := url.Parse(endpoints.AcceptChannelRewardRedemptionsURL())
 	if err != nil {
 		return nil, err
@@ -148,6 +161,8 @@ func (c *channelRewardClient) AcceptRewardRedemption(ctx context.Context, access
 	return c.channelRewardRedemptionDecision(ctx, accessToken, acceptRewardRedemptionURL.String(), redemptionIDs)
 }
 func (c *channelRewardClient) RejectRewardRedemption(ctx context.Context, accessToken string, redemptionIDs []string) (*kickapitypes.RedemptionDecision, error) {
+	ctx = c.client.requester.WithOperation(ctx, "ChannelReward.RejectRewardRedemption")
+
 	rejectRewardRedemptionURL, err := url.Parse(endpoints.RejectChannelRewardRedemptionsURL())
 	if err != nil {
 		return nil, err
@@ -155,6 +170,37 @@ func (c *channelRewardClient) RejectRewardRedemption(ctx context.Context, access
 	return c.channelRewardRedemptionDecision(ctx, accessToken, rejectRewardRedemptionURL.String(), redemptionIDs)
 }
 
+func (c *channelRewardClient) AcceptAllRewardRedemptions(ctx context.Context, accessToken string, redemptionIDs []string) (*kickapitypes.RedemptionDecision, error) {
+	ctx = c.client.requester.WithOperation(ctx, "ChannelReward.AcceptAllRewardRedemptions")
+
+	return c.allChannelRewardRedemptionDecisions(ctx, accessToken, redemptionIDs, c.AcceptRewardRedemption)
+}
+
+func (c *channelRewardClient) RejectAllRewardRedemptions(ctx context.Context, accessToken string, redemptionIDs []string) (*kickapitypes.RedemptionDecision, error) {
+	ctx = c.client.requester.WithOperation(ctx, "ChannelReward.RejectAllRewardRedemptions")
+
+	return c.allChannelRewardRedemptionDecisions(ctx, accessToken, redemptionIDs, c.RejectRewardRedemption)
+}
+
+func (c *channelRewardClient) allChannelRewardRedemptionDecisions(ctx context.Context, accessToken string, redemptionIDs []string, decide func(context.Context, string, []string) (*kickapitypes.RedemptionDecision, error)) (*kickapitypes.RedemptionDecision, error) {
+	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
+		return nil, err
+	}
+	if err := kickerrors.ValidateMinItems("redemptionIDs", redemptionIDs, 1); err != nil {
+		return nil, err
+	}
+
+	results, err := batch.Run(ctx, redemptionIDs, 25, c.client.batchConcurrency, func(ctx context.Context, chunk []string) (*kickapitypes.RedemptionDecision, error) {
+		return decide(ctx, accessToken, chunk)
+	})
+
+	message, data := batch.Merge(results, func(result *kickapitypes.RedemptionDecision) (string, []kickapitypes.RedemptionDecisionData) {
+		return result.Message, result.Data
+	})
+
+	return &kickapitypes.RedemptionDecision{Message: message, Data: data}, err
+}
+
 func (c *channelRewardClient) channelRewardRedemptionDecision(ctx context.Context, accessToken string, url string, redemptionIDs []string) (*kickapitypes.RedemptionDecision, error) {
 	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
 		return nil, err
@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"strconv"
 
+	"github.com/henrikah/kick-go-sdk/v2/internal/batch"
 	"github.com/henrikah/kick-go-sdk/v2/internal/endpoints"
 	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
 	"github.com/henrikah/kick-go-sdk/v2/kickcontracts"
@@ -23,6 +24,8 @@ func newChannelService(client *apiClient) kickcontracts.Channel {
 }
 
 func (c *channelClient) GetChannelsByBroadcasterUserID(ctx context.Context, accessToken string, broadcasterUserIDs []int64) (*kickapitypes.Channels, error) {
+	ctx = c.client.requester.WithOperation(ctx, "Channel.GetChannelsByBroadcasterUserID")
+
 	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
 		return nil, err
 	}
@@ -51,14 +54,20 @@ func (c *channelClient) GetChannelsByBroadcasterUserID(ctx context.Context, acce
 }
 
 func (c *channelClient) GetChannelByBroadcasterUserID(ctx context.Context, accessToken string, broadcasterUserID int64) (*kickapitypes.Channels, error) {
+	ctx = c.client.requester.WithOperation(ctx, "Channel.GetChannelByBroadcasterUserID")
+
 	return c.GetChannelsByBroadcasterUserID(ctx, accessToken, []int64{broadcasterUserID})
 }
 
 func (c *channelClient) GetCurrentBroadcasterChannel(ctx context.Context, accessToken string) (*kickapitypes.Channels, error) {
+	ctx = c.client.requester.WithOperation(ctx, "Channel.GetCurrentBroadcasterChannel")
+
 	return c.GetChannelsByBroadcasterUserID(ctx, accessToken, nil)
 }
 
 func (c *channelClient) GetChannelsByBroadcasterSlug(ctx context.Context, accessToken string, slugs []string) (*kickapitypes.Channels, error) {
+	ctx = c.client.requester.WithOperation(ctx, "Channel.GetChannelsByBroadcasterSlug")
+
 	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
 		return nil, err
 	}
@@ -92,7 +101,48 @@ func (c *channelClient) GetChannelsByBroadcasterSlug(ctx context.Context, access
 	return &channelsData, nil
 }
 
+func (c *channelClient) GetAllChannelsByBroadcasterUserID(ctx context.Context, accessToken string, broadcasterUserIDs []int64) (*kickapitypes.Channels, error) {
+	ctx = c.client.requester.WithOperation(ctx, "Channel.GetAllChannelsByBroadcasterUserID")
+
+	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
+		return nil, err
+	}
+	if err := kickerrors.ValidateMinItems("broadcasterUserIDs", broadcasterUserIDs, 1); err != nil {
+		return nil, err
+	}
+
+	results, err := batch.Run(ctx, broadcasterUserIDs, 50, c.client.batchConcurrency, func(ctx context.Context, chunk []int64) (*kickapitypes.Channels, error) {
+		return c.GetChannelsByBroadcasterUserID(ctx, accessToken, chunk)
+	})
+
+	return mergeChannels(results), err
+}
+
+func (c *channelClient) GetAllChannelsByBroadcasterSlug(ctx context.Context, accessToken string, slugs []string) (*kickapitypes.Channels, error) {
+	ctx = c.client.requester.WithOperation(ctx, "Channel.GetAllChannelsByBroadcasterSlug")
+
+	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
+		return nil, err
+	}
+	if err := kickerrors.ValidateMinItems("slugs", slugs, 1); err != nil {
+		return nil, err
+	}
+	for _, slug := range slugs {
+		if err := kickerrors.ValidateMaxCharacters("slugs", slug, 25); err != nil {
+			return nil, err
+		}
+	}
+
+	results, err := batch.Run(ctx, slugs, 50, c.client.batchConcurrency, func(ctx context.Context, chunk []string) (*kickapitypes.Channels, error) {
+		return c.GetChannelsByBroadcasterSlug(ctx, accessToken, chunk)
+	})
+
+	return mergeChannels(results), err
+}
+
 func (c *channelClient) UpdateChannel(ctx context.Context, accessToken string, updateChannelData kickapitypes.UpdateChannelRequest) error {
+	ctx = c.client.requester.WithOperation(ctx, "Channel.UpdateChannel")
+
 	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
 		return err
 	}
@@ -111,3 +161,10 @@ func (c *channelClient) UpdateChannel(ctx context.Context, accessToken string, u
 
 	return nil
 }
+
+func mergeChannels(results []*kickapitypes.Channels) *kickapitypes.Channels {
+	message, data := batch.Merge(results, func(result *kickapitypes.Channels) (string, []kickapitypes.ChannelData) {
+		return result.Message, result.Data
+	})
+	return &kickapitypes.Channels{Message: message, Data: data}
+}
@@ -21,6 +21,8 @@ func newChatService(client *apiClient) kickcontracts.Chat {
 }
 
 func (c *chatClient) SendChatMessageAsUser(ctx context.Context, accessToken string, broadcasterUserID int, replyToMessageID *string, message string) (*kickapitypes.SendChatResponse, error) {
+	ctx = c.client.requester.WithOperation(ctx, "Chat.SendChatMessageAsUser")
+
 	if err := kickerrors.ValidateBroadcasterUserID(broadcasterUserID); err != nil {
 		return nil, err
 	}
@@ -36,6 +38,8 @@ func (c *chatClient) SendChatMessageAsUser(ctx context.Context, accessToken stri
 }
 
 func (c *chatClient) SendChatMessageAsBot(ctx context.Context, accessToken string, replyToMessageID *string, message string) (*kickapitypes.SendChatResponse, error) {
+	ctx = c.client.requester.WithOperation(ctx, "Chat.SendChatMessageAsBot")
+
 	chatRequest := kickapitypes.SendChatRequest{
 		Content:          message,
 		ReplyToMessageID: replyToMessageID,
@@ -46,6 +50,8 @@ func (c *chatClient) SendChatMessageAsBot(ctx context.Context, accessToken strin
 }
 
 func (c *chatClient) DeleteChatMessage(ctx context.Context, accessToken string, messageID string) error {
+	ctx = c.client.requester.WithOperation(ctx, "Chat.DeleteChatMessage")
+
 	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
 		return err
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/http"
 	"net/url"
+	"slices"
 
 	"github.com/henrikah/kick-go-sdk/v2/enums/kickwebhookenum"
 	"github.com/henrikah/kick-go-sdk/v2/internal/endpoints"
@@ -23,6 +24,8 @@ func newEventsSubscriptionService(client *apiClient) kickcontracts.EventsSubscri
 }
 
 func (c *eventsSubscriptionClient) GetEventSubscriptions(ctx context.Context, accessToken string) (*kickapitypes.EventSubscription, error) {
+	ctx = c.client.requester.WithOperation(ctx, "EventsSubscription.GetEventSubscriptions")
+
 	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
 		return nil, err
 	}
@@ -37,44 +40,119 @@ func (c *eventsSubscriptionClient) GetEventSubscriptions(ctx context.Context, ac
 }
 
 func (c *eventsSubscriptionClient) CreateEventSubscriptions(ctx context.Context, accessToken string, events []kickwebhookenum.WebhookType) (*kickapitypes.CreateEventSubscriptionsResponse, error) {
+	ctx = c.client.requester.WithOperation(ctx, "EventsSubscription.CreateEventSubscriptions")
+
 	return c.createEventSubscriptions(ctx, accessToken, nil, events)
 }
 
 func (c *eventsSubscriptionClient) CreateEventSubscriptionsAsApp(ctx context.Context, accessToken string, broadcasterUserID int, events []kickwebhookenum.WebhookType) (*kickapitypes.CreateEventSubscriptionsResponse, error) {
+	ctx = c.client.requester.WithOperation(ctx, "EventsSubscription.CreateEventSubscriptionsAsApp")
+
 	return c.createEventSubscriptions(ctx, accessToken, &broadcasterUserID, events)
 }
 
-func (c *eventsSubscriptionClient) createEventSubscriptions(ctx context.Context, accessToken string, broadcasterUserID *int, events []kickwebhookenum.WebhookType) (*kickapitypes.CreateEventSubscriptionsResponse, error) {
+func (c *eventsSubscriptionClient) CreateVersionedEventSubscriptions(ctx context.Context, accessToken string, broadcasterUserID *int, events []kickapitypes.EventObject) (*kickapitypes.CreateEventSubscriptionsResponse, error) {
+	ctx = c.client.requester.WithOperation(ctx, "EventsSubscription.CreateVersionedEventSubscriptions")
+
 	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
 		return nil, err
 	}
 	if err := kickerrors.ValidateMinItems("events", events, 1); err != nil {
 		return nil, err
 	}
+	for _, event := range events {
+		if err := kickerrors.ValidateNotEmpty("events.name", event.Name); err != nil {
+			return nil, err
+		}
+		if err := kickerrors.ValidateMinValue("events.version", event.Version, 1); err != nil {
+			return nil, err
+		}
+	}
 
-	createEventSubscriptionsRequest := kickapitypes.CreateEventSubscriptionRequest{
-		BroadcasterUserID: broadcasterUserID,
-		Events:            make([]kickapitypes.EventObject, len(events)),
-		Method:            "webhook",
+	return c.sendEventSubscriptions(ctx, accessToken, broadcasterUserID, events)
+}
+
+func (c *eventsSubscriptionClient) createEventSubscriptions(ctx context.Context, accessToken string, broadcasterUserID *int, events []kickwebhookenum.WebhookType) (*kickapitypes.CreateEventSubscriptionsResponse, error) {
+	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
+		return nil, err
+	}
+	if err := kickerrors.ValidateMinItems("events", events, 1); err != nil {
+		return nil, err
 	}
 
+	eventObjects := make([]kickapitypes.EventObject, len(events))
 	for i := range events {
-		createEventSubscriptionsRequest.Events[i] = kickapitypes.EventObject{
+		eventObjects[i] = kickapitypes.EventObject{
 			Name:    string(events[i]),
 			Version: 1,
 		}
 	}
 
+	return c.sendEventSubscriptions(ctx, accessToken, broadcasterUserID, eventObjects)
+}
+
+func (c *eventsSubscriptionClient) sendEventSubscriptions(ctx context.Context, accessToken string, broadcasterUserID *int, events []kickapitypes.EventObject) (*kickapitypes.CreateEventSubscriptionsResponse, error) {
+	createEventSubscriptionsRequest := kickapitypes.CreateEventSubscriptionRequest{
+		BroadcasterUserID: broadcasterUserID,
+		Events:            events,
+		Method:            "webhook",
+	}
+
 	var createEventSubscriptionsResponse kickapitypes.CreateEventSubscriptionsResponse
 	err := c.client.requester.MakeJSONRequest(ctx, http.MethodPost, endpoints.ViewEventsSubscriptionsDetailsURL(), createEventSubscriptionsRequest, &accessToken, &createEventSubscriptionsResponse)
 	if err != nil {
 		return nil, err
 	}
 
+	if partialErr := classifyEventSubscriptions(events, createEventSubscriptionsResponse.Data); partialErr != nil {
+		if c.client.strictEventSubscriptions {
+			return nil, partialErr
+		}
+		return &createEventSubscriptionsResponse, partialErr
+	}
+
 	return &createEventSubscriptionsResponse, nil
 }
 
+// classifyEventSubscriptions matches every requested event with its result and returns a PartialSubscriptionError
+// when an event has an error, has no subscription ID or is missing from the response.
+func classifyEventSubscriptions(events []kickapitypes.EventObject, results []kickapitypes.CreateEventSubscriptionData) *kickerrors.PartialSubscriptionError {
+	remaining := slices.Clone(results)
+
+	var subscribed []kickerrors.SubscribedEvent
+	var failed []kickerrors.SubscriptionFailure
+	for _, event := range events {
+		index := slices.IndexFunc(remaining, func(result kickapitypes.CreateEventSubscriptionData) bool {
+			return result.Name == event.Name && (result.Version == event.Version || result.Version == 0)
+		})
+		if index < 0 {
+			failed = append(failed, kickerrors.SubscriptionFailure{Event: event.Name, Version: event.Version, Reason: "missing from response"})
+			continue
+		}
+
+		result := remaining[index]
+		remaining = slices.Delete(remaining, index, index+1)
+
+		switch {
+		case result.Error != "":
+			failed = append(failed, kickerrors.SubscriptionFailure{Event: event.Name, Version: event.Version, Reason: result.Error})
+		case result.SubscriptionID == "":
+			failed = append(failed, kickerrors.SubscriptionFailure{Event: event.Name, Version: event.Version, Reason: "no subscription ID returned"})
+		default:
+			subscribed = append(subscribed, kickerrors.SubscribedEvent{Event: event.Name, Version: event.Version, SubscriptionID: result.SubscriptionID})
+		}
+	}
+
+	if len(failed) == 0 {
+		return nil
+	}
+
+	return kickerrors.SetPartialSubscriptionError(subscribed, failed)
+}
+
 func (c *eventsSubscriptionClient) DeleteEventSubscriptions(ctx context.Context, accessToken string, subscriptionIDs []string) error {
+	ctx = c.client.requester.WithOperation(ctx, "EventsSubscription.DeleteEventSubscriptions")
+
 	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
 		return err
 	}
@@ -0,0 +1,97 @@
+// Package batch splits batch-limited requests into chunks and runs them with bounded concurrency.
+package batch
+
+import (
+	"context"
+	"sync"
+
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+)
+
+// DefaultConcurrency is used when no positive concurrency is configured.
+const DefaultConcurrency = 4
+
+// Chunk splits items into slices of at most size items while keeping the input order.
+func Chunk[T any](items []T, size int) [][]T {
+	if size < 1 {
+		size = 1
+	}
+
+	chunks := make([][]T, 0, (len(items)+size-1)/size)
+	for start := 0; start < len(items); start += size {
+		end := min(start+size, len(items))
+		chunks = append(chunks, items[start:end])
+	}
+	return chunks
+}
+
+// Merge concatenates the data of every non-nil result in chunk order and returns it with the first
+// non-empty message. The returned data is never nil.
+func Merge[R any, D any](results []*R, split func(result *R) (string, []D)) (string, []D) {
+	message, data := "", []D{}
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+		resultMessage, resultData := split(result)
+		if message == "" {
+			message = resultMessage
+		}
+		data = append(data, resultData...)
+	}
+	return message, data
+}
+
+// Run executes fn for every chunk of items with at most concurrency calls in flight.
+//
+// Results are returned in chunk order. Chunks that failed have a zero value result and
+// are reported through a *kickerrors.BatchError, which is nil when every chunk succeeded.
+func Run[T any, R any](ctx context.Context, items []T, size int, concurrency int, fn func(ctx context.Context, chunk []T) (R, error)) ([]R, error) {
+	if concurrency < 1 {
+		concurrency = DefaultConcurrency
+	}
+
+	chunks := Chunk(items, size)
+	results := make([]R, len(chunks))
+	errs := make([]error, len(chunks))
+
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, chunk := range chunks {
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, chunk []T) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			results[i], errs[i] = fn(ctx, chunk)
+		}(i, chunk)
+	}
+	wg.Wait()
+
+	var failed []kickerrors.ChunkError
+	for i, err := range errs {
+		if err == nil {
+			continue
+		}
+		failed = append(failed, kickerrors.ChunkError{
+			Index:  i,
+			Offset: i * size,
+			Size:   len(chunks[i]),
+			Err:    err,
+		})
+	}
+
+	if len(failed) > 0 {
+		return results, kickerrors.SetBatchError(len(chunks), failed)
+	}
+
+	return results, nil
+}
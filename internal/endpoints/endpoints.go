@@ -2,7 +2,9 @@
 package endpoints
 
 import (
+	"net/url"
 	"strconv"
+	"strings"
 
 	"github.com/henrikah/kick-go-sdk/v2/internal/helpers"
 )
@@ -186,3 +188,54 @@ func AcceptChannelRewardRedemptionsURL() string {
 func RejectChannelRewardRedemptionsURL() string {
 	return helpers.ConcatURL(apiHostname, rejectChannelRewardRedemption)
 }
+
+// staticPaths are the paths that do not contain any dynamic segment.
+var staticPaths = map[string]bool{
+	codeExchangePath:                     true,
+	revokeTokenPath:                      true,
+	tokenIntrospectPath:                  true,
+	searchCategoriesPath:                 true,
+	viewUsersDetailsPath:                 true,
+	viewChannelsDetailsPath:              true,
+	sendChatMessagePath:                  true,
+	banUserPath:                          true,
+	viewLivestreamsDetailsPath:           true,
+	viewCurrentUserLivestreamDetailsPath: true,
+	viewWebhookPublicKeyPath:             true,
+	viewEventsSubscriptionsDetailsPath:   true,
+	getKicksLeaderboardPath:              true,
+	viewChannelRewards:                   true,
+	viewChannelRewardRedemption:          true,
+	acceptChannelRewardRedemption:        true,
+	rejectChannelRewardRedemption:        true,
+}
+
+// dynamicPaths maps the paths that end in a dynamic segment to the placeholder of that segment.
+var dynamicPaths = map[string]string{
+	viewCategoryDetailsPath: "{category_id}",
+	deleteChatMessagePath:   "{message_id}",
+	updateChannelReward:     "{reward_id}",
+}
+
+// Template returns the endpoint template of a URL built by this package, without hostname and query.
+// Dynamic segments are replaced by placeholders, e.g. "public/v1/chat/{message_id}".
+// Unknown paths are returned as they are.
+func Template(urlStr string) string {
+	parsedURL, err := url.Parse(urlStr)
+	if err != nil {
+		return ""
+	}
+
+	path := strings.TrimPrefix(parsedURL.Path, "/")
+	if staticPaths[path] {
+		return path
+	}
+
+	if index := strings.LastIndex(path, "/"); index > 0 {
+		if placeholder, ok := dynamicPaths[path[:index]]; ok {
+			return path[:index+1] + placeholder
+		}
+	}
+
+	return path
+}
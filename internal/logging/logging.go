@@ -0,0 +1,93 @@
+// Package logging contains the helpers the SDK uses to log through log/slog without leaking secrets.
+package logging
+
+import (
+	"log/slog"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Redacted replaces every secret value in the log output.
+const Redacted = "[REDACTED]"
+
+var (
+	sensitiveHeaders = map[string]bool{
+		"Authorization":        true,
+		"Cookie":               true,
+		"Set-Cookie":           true,
+		"Kick-Event-Signature": true,
+	}
+	sensitiveParameters = map[string]bool{
+		"access_token":  true,
+		"client_secret": true,
+		"code":          true,
+		"code_verifier": true,
+		"email":         true,
+		"refresh_token": true,
+		"token":         true,
+	}
+	sensitiveJSONFields = regexp.MustCompile(`("(?:access_token|refresh_token|client_secret|code_verifier|token|email)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	emailAddresses      = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+)
+
+// OrDefault returns logger, or slog.Default() when logger is nil.
+func OrDefault(logger *slog.Logger) *slog.Logger {
+	if logger == nil {
+		return slog.Default()
+	}
+	return logger
+}
+
+// RedactHeaders returns the headers as a slog group with credentials and signatures redacted.
+func RedactHeaders(key string, header http.Header) slog.Attr {
+	attrs := make([]any, 0, len(header))
+	for name, values := range header {
+		value := strings.Join(values, ", ")
+		if IsSensitiveHeader(name) {
+			value = Redacted
+		}
+		attrs = append(attrs, slog.String(name, value))
+	}
+	return slog.Group(key, attrs...)
+}
+
+// RedactURL returns the URL with the values of sensitive query parameters redacted.
+func RedactURL(requestURL *url.URL) string {
+	if requestURL == nil {
+		return ""
+	}
+	if requestURL.RawQuery == "" {
+		return requestURL.String()
+	}
+
+	redacted := *requestURL
+	redacted.RawQuery = RedactValues(redacted.Query()).Encode()
+
+	return redacted.String()
+}
+
+// RedactValues returns a copy of the query or form values with the values of sensitive parameters redacted.
+func RedactValues(values url.Values) url.Values {
+	redacted := make(url.Values, len(values))
+	for name, value := range values {
+		if sensitiveParameters[strings.ToLower(name)] {
+			value = []string{Redacted}
+		}
+		redacted[name] = value
+	}
+	return redacted
+}
+
+// IsSensitiveHeader reports whether the header carries credentials or signatures.
+func IsSensitiveHeader(name string) bool {
+	return sensitiveHeaders[http.CanonicalHeaderKey(name)]
+}
+
+// RedactBody returns the body with tokens, secrets and email addresses redacted.
+func RedactBody(body []byte) string {
+	redacted := sensitiveJSONFields.ReplaceAll(body, []byte(`${1}"`+Redacted+`"`))
+	redacted = emailAddresses.ReplaceAll(redacted, []byte(Redacted))
+	return string(redacted)
+}
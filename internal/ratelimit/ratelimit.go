@@ -0,0 +1,54 @@
+// Package ratelimit paces calls to at most one per interval.
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Limiter lets one caller through per interval, callers wait for their turn in the order they arrive.
+// A nil Limiter or one with a zero interval never waits.
+type Limiter struct {
+	interval time.Duration
+	mu       sync.Mutex
+	next     time.Time
+}
+
+// New creates a Limiter that lets one caller through per interval.
+func New(interval time.Duration) *Limiter {
+	return &Limiter{
+		interval: interval,
+	}
+}
+
+// Wait blocks until the caller may proceed or ctx is done.
+func (l *Limiter) Wait(ctx context.Context) error {
+	if l == nil || l.interval <= 0 {
+		return ctx.Err()
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	slot := l.next
+	if slot.Before(now) {
+		slot = now
+	}
+	l.next = slot.Add(l.interval)
+	l.mu.Unlock()
+
+	delay := time.Until(slot)
+	if delay <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
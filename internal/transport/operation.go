@@ -0,0 +1,28 @@
+package transport
+
+import (
+	"context"
+
+	"github.com/henrikah/kick-go-sdk/v2/kickmeta"
+)
+
+type operationKey struct{}
+
+// WithOperation names the service method making the requests with ctx, e.g. "Chat.SendChatMessageAsBot".
+// An operation already set on ctx is kept, so methods built on other methods report the method the caller invoked.
+// The context is returned as is when nothing consumes the operation name.
+func (r *Requester) WithOperation(ctx context.Context, operation string) context.Context {
+	if r.tracer == nil && r.metrics == nil && len(r.interceptors) == 0 && kickmeta.FromContext(ctx) == nil {
+		return ctx
+	}
+	if _, ok := ctx.Value(operationKey{}).(string); ok {
+		return ctx
+	}
+	return context.WithValue(ctx, operationKey{}, operation)
+}
+
+// operationFromContext returns the operation set by WithOperation, or an empty string.
+func operationFromContext(ctx context.Context) string {
+	operation, _ := ctx.Value(operationKey{}).(string)
+	return operation
+}
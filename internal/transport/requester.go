@@ -5,23 +5,76 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"io"
-	"log"
+	"log/slog"
 	"net/http"
+	"time"
 
+	"github.com/henrikah/kick-go-sdk/v2/internal/endpoints"
 	"github.com/henrikah/kick-go-sdk/v2/internal/httpclient"
+	"github.com/henrikah/kick-go-sdk/v2/internal/logging"
 	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/kickinterceptor"
+	"github.com/henrikah/kick-go-sdk/v2/kickmeta"
+	"github.com/henrikah/kick-go-sdk/v2/kicktelemetry"
 )
 
+var errInterceptorNoResponse = errors.New("interceptor returned neither a response nor an error")
+
 type Requester struct {
-	httpClient httpclient.ClientInterface
+	httpClient   httpclient.ClientInterface
+	logger       *slog.Logger
+	tracer       kicktelemetry.Tracer
+	metrics      kicktelemetry.MetricsRecorder
+	interceptors []kickinterceptor.Interceptor
+}
+
+// Option configures optional behaviour of the Requester.
+type Option func(*Requester)
+
+// WithLogger sets the logger used for requests and responses. A nil logger falls back to slog.Default().
+func WithLogger(logger *slog.Logger) Option {
+	return func(r *Requester) {
+		r.logger = logging.OrDefault(logger)
+	}
+}
+
+// WithTracer sets the tracer that starts a client span for every request. A nil tracer disables tracing.
+func WithTracer(tracer kicktelemetry.Tracer) Option {
+	return func(r *Requester) {
+		r.tracer = tracer
+	}
+}
+
+// WithMetrics sets the recorder that records the outcome of every request. A nil recorder disables metrics.
+func WithMetrics(metrics kicktelemetry.MetricsRecorder) Option {
+	return func(r *Requester) {
+		r.metrics = metrics
+	}
+}
+
+// WithInterceptors sets the interceptors that wrap every request, the first interceptor is the outermost.
+func WithInterceptors(interceptors ...kickinterceptor.Interceptor) Option {
+	return func(r *Requester) {
+		r.interceptors = interceptors
+	}
 }
 
-func NewRequester(httpClient httpclient.ClientInterface) (*Requester, error) {
+func NewRequester(httpClient httpclient.ClientInterface, options ...Option) (*Requester, error) {
 	if err := kickerrors.ValidateNotNil("httpClient", httpClient); err != nil {
 		return nil, err
 	}
-	return &Requester{httpClient: httpClient}, nil
+
+	requester := &Requester{
+		httpClient: httpClient,
+		logger:     slog.Default(),
+	}
+	for _, option := range options {
+		option(requester)
+	}
+
+	return requester, nil
 }
 
 func (r *Requester) MakeJSONRequest(ctx context.Context, method, urlStr string, requestBody any, accessToken *string, out any) error {
@@ -49,9 +102,45 @@ func (r *Requester) MakeDeleteRequest(ctx context.Context, urlStr string, access
 }
 
 func (r *Requester) makeRequestWithBody(ctx context.Context, method, urlStr string, body io.Reader, contentType string, accessToken *string, out any) error {
+	if r.tracer == nil && r.metrics == nil {
+		_, err := r.doRequest(ctx, method, urlStr, body, contentType, accessToken, out)
+		return err
+	}
+
+	info := kicktelemetry.RequestInfo{
+		Operation: operationFromContext(ctx),
+		Method:    method,
+		Endpoint:  endpoints.Template(urlStr),
+	}
+
+	var span kicktelemetry.RequestSpan
+	if r.tracer != nil {
+		ctx, span = r.tracer.StartRequestSpan(ctx, info)
+	}
+
+	start := time.Now()
+	statusCode, err := r.doRequest(ctx, method, urlStr, body, contentType, accessToken, out)
+	result := kicktelemetry.RequestResult{
+		StatusCode: statusCode,
+		Duration:   time.Since(start),
+		Err:        err,
+	}
+
+	if span != nil {
+		span.End(result)
+	}
+	if r.metrics != nil {
+		r.metrics.RecordRequest(ctx, info, result)
+	}
+
+	return err
+}
+
+// doRequest sends the request and decodes the response into out, it returns the status code of the response or 0 when none was received.
+func (r *Requester) doRequest(ctx context.Context, method, urlStr string, body io.Reader, contentType string, accessToken *string, out any) (int, error) {
 	req, err := http.NewRequestWithContext(ctx, method, urlStr, body)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	req.Header.Set("Accept", "application/json")
@@ -63,24 +152,99 @@ func (r *Requester) makeRequestWithBody(ctx context.Context, method, urlStr stri
 		req.Header.Set("Content-Type", contentType)
 	}
 
-	resp, err := r.httpClient.Do(req)
+	requestURL := logging.RedactURL(req.URL)
+	r.logger.DebugContext(ctx, "kick: sending request",
+		slog.String("method", method),
+		slog.String("url", requestURL),
+		logging.RedactHeaders("headers", req.Header),
+	)
+
+	start := time.Now()
+	resp, err := r.send(ctx, req)
+	if collector := kickmeta.FromContext(ctx); collector != nil {
+		collector.Record(responseMeta(ctx, req, resp, time.Since(start)))
+	}
 	if err != nil {
-		return err
+		r.logger.WarnContext(ctx, "kick: request failed",
+			slog.String("method", method),
+			slog.String("url", requestURL),
+			slog.Duration("duration", time.Since(start)),
+			slog.Any("error", err),
+		)
+		return 0, err
 	}
 	defer func() {
 		if err := resp.Body.Close(); err != nil {
-			log.Printf("failed to close request body: %v", err)
+			r.logger.WarnContext(ctx, "kick: failed to close response body",
+				slog.String("method", method),
+				slog.String("url", requestURL),
+				slog.Any("error", err),
+			)
 		}
 	}()
 
 	if resp.StatusCode < http.StatusOK || resp.StatusCode > http.StatusNoContent {
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return kickerrors.SetAPIError(resp.StatusCode, string(bodyBytes), req.URL.String())
+		r.logger.DebugContext(ctx, "kick: received error response",
+			slog.String("method", method),
+			slog.String("url", requestURL),
+			slog.Int("status", resp.StatusCode),
+			slog.Duration("duration", time.Since(start)),
+			slog.String("body", logging.RedactBody(bodyBytes)),
+		)
+		return resp.StatusCode, kickerrors.SetAPIError(resp.StatusCode, string(bodyBytes), req.URL.String())
 	}
 
+	r.logger.DebugContext(ctx, "kick: received response",
+		slog.String("method", method),
+		slog.String("url", requestURL),
+		slog.Int("status", resp.StatusCode),
+		slog.Duration("duration", time.Since(start)),
+	)
+
 	if resp.StatusCode != http.StatusNoContent && out != nil {
-		return json.NewDecoder(resp.Body).Decode(out)
+		return resp.StatusCode, json.NewDecoder(resp.Body).Decode(out)
 	}
 
-	return nil
+	return resp.StatusCode, nil
+}
+
+// send passes the request through the interceptors and sends it with the HTTP client.
+func (r *Requester) send(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if len(r.interceptors) == 0 {
+		return r.httpClient.Do(req)
+	}
+
+	return r.intercept(operationFromContext(ctx), 0, req)
+}
+
+// intercept calls the interceptor at index with an invoker for the rest of the chain.
+func (r *Requester) intercept(operation string, index int, req *http.Request) (*http.Response, error) {
+	if index == len(r.interceptors) {
+		return r.httpClient.Do(req)
+	}
+
+	resp, err := r.interceptors[index](operation, req, func(req *http.Request) (*http.Response, error) {
+		return r.intercept(operation, index+1, req)
+	})
+	if err == nil && resp == nil {
+		return nil, errInterceptorNoResponse
+	}
+
+	return resp, err
+}
+
+// responseMeta describes a completed request, resp is nil when no response was received.
+func responseMeta(ctx context.Context, req *http.Request, resp *http.Response, duration time.Duration) kickmeta.ResponseMeta {
+	meta := kickmeta.ResponseMeta{
+		Operation:  operationFromContext(ctx),
+		Duration:   duration,
+		Attempts:   1,
+		RequestURL: req.URL.String(),
+	}
+	if resp != nil {
+		meta.StatusCode = resp.StatusCode
+		meta.Header = resp.Header
+	}
+	return meta
 }
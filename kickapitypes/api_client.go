@@ -1,9 +1,34 @@
 package kickapitypes
 
 import (
+	"log/slog"
+
 	"github.com/henrikah/kick-go-sdk/v2/internal/httpclient"
+	"github.com/henrikah/kick-go-sdk/v2/kickinterceptor"
+	"github.com/henrikah/kick-go-sdk/v2/kicktelemetry"
 )
 
 type APIClientConfig struct {
 	HTTPClient httpclient.ClientInterface
+
+	// BatchConcurrency limits how many chunks the "All" batch methods request in parallel.
+	// Defaults to 4 when zero or negative.
+	BatchConcurrency int
+
+	// StrictEventSubscriptions makes the create event subscriptions methods return a nil response together with the
+	// *kickerrors.PartialSubscriptionError when any event fails. By default the response is returned alongside the error.
+	StrictEventSubscriptions bool
+
+	// Logger receives request and response logs with access tokens and emails redacted.
+	// Defaults to slog.Default() when nil.
+	Logger *slog.Logger
+
+	// Tracer starts a client span for every API request. Telemetry is skipped when nil.
+	Tracer kicktelemetry.Tracer
+
+	// Metrics records the duration and outcome of every API request. Telemetry is skipped when nil.
+	Metrics kicktelemetry.MetricsRecorder
+
+	// Interceptors wrap every API request, the first interceptor is the outermost.
+	Interceptors []kickinterceptor.Interceptor
 }
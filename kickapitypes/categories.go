@@ -12,3 +12,13 @@ type GetCategoriesResponse struct {
 	Message    string     `json:"message,omitempty"`
 	Pagination Pagination `json:"pagination"`
 }
+
+type GetCategoryResponse struct {
+	Data    Category `json:"data"`
+	Message string   `json:"message,omitempty"`
+}
+
+type GetCategoriesByIDResponse struct {
+	Data    []Category `json:"data"`
+	Message string     `json:"message,omitempty"`
+}
@@ -1,6 +1,9 @@
 package kickapitypes
 
-import "github.com/henrikah/kick-go-sdk/v2/enums/kickchannelrewardstatus"
+import (
+	"github.com/henrikah/kick-go-sdk/v2/enums/kickchannelrewardstatus"
+	"github.com/henrikah/kick-go-sdk/v2/kicktime"
+)
 
 type ChannelRewards struct {
 	Data    []ChannelRewardData `json:"data"`
@@ -62,7 +65,7 @@ type Redeemer struct {
 
 type RedemptionData struct {
 	ID         string                                      `json:"id"`
-	RedeemedAt string                                      `json:"redeemed_at"`
+	RedeemedAt kicktime.KickTime                           `json:"redeemed_at"`
 	Redeemer   Redeemer                                    `json:"redeemer"`
 	Status     kickchannelrewardstatus.ChannelRewardStatus `json:"status"`
 	UserInput  string                                      `json:"user_input"`
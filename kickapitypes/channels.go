@@ -1,5 +1,7 @@
 package kickapitypes
 
+import "github.com/henrikah/kick-go-sdk/v2/kicktime"
+
 type Channels struct {
 	Data    []ChannelData `json:"data"`
 	Message string        `json:"message"`
@@ -16,15 +18,15 @@ type ChannelData struct {
 }
 
 type ChannelStream struct {
-	CustomTags  []string `json:"custom_tags"`
-	IsLive      bool     `json:"is_live"`
-	IsMature    bool     `json:"is_mature"`
-	Key         string   `json:"key"`
-	Language    string   `json:"language"`
-	StartTime   string   `json:"start_time"`
-	Thumbnail   string   `json:"thumbnail"`
-	URL         string   `json:"url"`
-	ViewerCount int      `json:"viewer_count"`
+	CustomTags  []string          `json:"custom_tags"`
+	IsLive      bool              `json:"is_live"`
+	IsMature    bool              `json:"is_mature"`
+	Key         string            `json:"key"`
+	Language    string            `json:"language"`
+	StartTime   kicktime.KickTime `json:"start_time"`
+	Thumbnail   string            `json:"thumbnail"`
+	URL         string            `json:"url"`
+	ViewerCount int               `json:"viewer_count"`
 }
 
 type UpdateChannelRequest struct {
@@ -1,19 +1,21 @@
 package kickapitypes
 
+import "github.com/henrikah/kick-go-sdk/v2/kicktime"
+
 type EventSubscription struct {
 	Data    []EventSubscriptionData `json:"data"`
 	Message string                  `json:"message"`
 }
 
 type EventSubscriptionData struct {
-	AppID             string `json:"app_id"`
-	BroadcasterUserID int    `json:"broadcaster_user_id"`
-	CreatedAt         string `json:"created_at"`
-	Event             string `json:"event"`
-	ID                string `json:"id"`
-	Method            string `json:"method"`
-	UpdatedAt         string `json:"updated_at"`
-	Version           int    `json:"version"`
+	AppID             string            `json:"app_id"`
+	BroadcasterUserID int               `json:"broadcaster_user_id"`
+	CreatedAt         kicktime.KickTime `json:"created_at"`
+	Event             string            `json:"event"`
+	ID                string            `json:"id"`
+	Method            string            `json:"method"`
+	UpdatedAt         kicktime.KickTime `json:"updated_at"`
+	Version           int               `json:"version"`
 }
 
 type CreateEventSubscriptionRequest struct {
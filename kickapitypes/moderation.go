@@ -1,10 +1,13 @@
 package kickapitypes
 
 type ModerationResponse struct {
-	Data    any    `json:"data"`
-	Message string `json:"message"`
+	Data    ModerationData `json:"data"`
+	Message string         `json:"message"`
 }
 
+// ModerationData is the data of a moderation response, Kick currently returns an empty object.
+type ModerationData struct{}
+
 type ModerationRequest struct {
 	BroadcasterUserID int     `json:"broadcaster_user_id"`
 	Duration          *int    `json:"duration,omitempty"`
@@ -0,0 +1,40 @@
+// Package kickaudit keeps an audit log of moderation actions.
+//
+// A Recorder captures every BanUser, TimeOutUser, UnbanUser and DeleteChatMessage call made through an APIClient
+// with its interceptor, and every ban and timeout reported by the ModerationBanned webhook, including those by
+// human moderators. Query merges an API call with the webhook Kick sent for it and filters the log by broadcaster,
+// moderator, target, action and time range. A FileStore keeps the log in a JSONL file:
+//
+//	store, err := kickaudit.NewFileStore("moderation.jsonl")
+//	if err != nil {
+//		log.Fatalf("could not open audit log: %v", err)
+//	}
+//	defer store.Close()
+//
+//	recorder, err := kickaudit.NewRecorder(kickaudit.RecorderConfig{Store: store})
+//	if err != nil {
+//		log.Fatalf("could not create recorder: %v", err)
+//	}
+//
+//	apiClient, err := kick.NewAPIClient(kickapitypes.APIClientConfig{
+//		HTTPClient:   http.DefaultClient,
+//		Interceptors: []kickinterceptor.Interceptor{recorder.Interceptor()},
+//	})
+//	if err != nil {
+//		log.Fatalf("could not create APIClient: %v", err)
+//	}
+//
+//	webhookClient.RegisterModerationBannedHandler(recorder.WebhookHandler())
+//
+//	ctx = kickaudit.WithActor(ctx, kickaudit.Actor{UserID: moderatorUserID, Username: "automod"})
+//	reason := "spam"
+//	_, err = apiClient.Moderation().BanUser(ctx, accessToken, broadcasterUserID, userID, &reason)
+//
+//	// Kick does not know the channel of a deleted message, so set it on the context.
+//	err = apiClient.Chat().DeleteChatMessage(kickaudit.WithBroadcaster(ctx, broadcasterUserID), accessToken, messageID)
+//
+//	entries, err := recorder.Query(ctx, kickaudit.Filter{
+//		BroadcasterUserID: broadcasterUserID,
+//		Since:             time.Now().Add(-24 * time.Hour),
+//	})
+package kickaudit
@@ -0,0 +1,109 @@
+package kickaudit
+
+import (
+	"slices"
+	"time"
+)
+
+// Action is the kind of moderation action of an Entry.
+type Action string
+
+const (
+	// ActionBan is a permanent ban.
+	ActionBan Action = "ban"
+	// ActionTimeout is a ban with an expiry.
+	ActionTimeout Action = "timeout"
+	// ActionUnban lifts a ban or timeout.
+	ActionUnban Action = "unban"
+	// ActionDeleteMessage deletes a chat message.
+	ActionDeleteMessage Action = "delete_message"
+)
+
+// Source is where an Entry was recorded from.
+type Source string
+
+const (
+	// SourceAPI is a moderation call made through the SDK.
+	SourceAPI Source = "api"
+	// SourceWebhook is a ModerationBanned webhook.
+	SourceWebhook Source = "webhook"
+)
+
+// Actor is a user taking or receiving a moderation action.
+type Actor struct {
+	UserID   int    `json:"user_id,omitempty"`
+	Username string `json:"username,omitempty"`
+}
+
+// Entry is a moderation action in the audit log.
+type Entry struct {
+	// At is when the action happened.
+	At time.Time `json:"at"`
+
+	// Action is the kind of action.
+	Action Action `json:"action"`
+
+	// Sources lists where the entry was recorded from. An API call and the webhook Kick sent for it are merged
+	// into one entry with both sources.
+	Sources []Source `json:"sources"`
+
+	// BroadcasterUserID is the channel of the action. For deleted messages it is the broadcaster set with
+	// WithBroadcaster, and zero when none was set.
+	BroadcasterUserID int `json:"broadcaster_user_id,omitempty"`
+
+	// Moderator took the action. For API calls it is the actor set with WithActor.
+	Moderator Actor `json:"moderator,omitzero"`
+
+	// Target received the action. It is empty for deleted messages.
+	Target Actor `json:"target,omitzero"`
+
+	// MessageID is the deleted message.
+	MessageID string `json:"message_id,omitempty"`
+
+	// Reason of the ban or timeout.
+	Reason string `json:"reason,omitempty"`
+
+	// ExpiresAt is when a timeout ends.
+	ExpiresAt time.Time `json:"expires_at,omitzero"`
+
+	// Error is the error of a failed API call. Failed calls are recorded as well.
+	Error string `json:"error,omitempty"`
+}
+
+// Filter selects entries in a query. Zero fields match every entry.
+type Filter struct {
+	// BroadcasterUserID matches entries of the channel. Deleted messages only match when their broadcaster was
+	// set with WithBroadcaster.
+	BroadcasterUserID int
+
+	ModeratorUserID int
+	TargetUserID    int
+
+	// Actions limits the query to the actions.
+	Actions []Action
+
+	// Since matches entries at or after the time.
+	Since time.Time
+
+	// Until matches entries before the time.
+	Until time.Time
+}
+
+// Match reports whether the entry matches the filter.
+func (f Filter) Match(entry Entry) bool {
+	switch {
+	case f.BroadcasterUserID != 0 && entry.BroadcasterUserID != f.BroadcasterUserID:
+		return false
+	case f.ModeratorUserID != 0 && entry.Moderator.UserID != f.ModeratorUserID:
+		return false
+	case f.TargetUserID != 0 && entry.Target.UserID != f.TargetUserID:
+		return false
+	case len(f.Actions) > 0 && !slices.Contains(f.Actions, entry.Action):
+		return false
+	case !f.Since.IsZero() && entry.At.Before(f.Since):
+		return false
+	case !f.Until.IsZero() && !entry.At.Before(f.Until):
+		return false
+	}
+	return true
+}
@@ -0,0 +1,319 @@
+package kickaudit
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"path"
+	"slices"
+	"time"
+
+	"github.com/henrikah/kick-go-sdk/v2/internal/logging"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/kickinterceptor"
+	"github.com/henrikah/kick-go-sdk/v2/kickwebhooktypes"
+)
+
+// mergeWindow is how far apart an API call and the webhook Kick sends for it may be recorded.
+const mergeWindow = time.Minute
+
+type (
+	actorKey       struct{}
+	broadcasterKey struct{}
+)
+
+// WithActor returns a context that records the actor as the moderator of the moderation calls made with it.
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor set with WithActor.
+func ActorFromContext(ctx context.Context) (Actor, bool) {
+	actor, ok := ctx.Value(actorKey{}).(Actor)
+	return actor, ok
+}
+
+// WithBroadcaster returns a context that records the broadcaster as the channel of the DeleteChatMessage calls made
+// with it. Kick does not send the channel of a deleted message, so without it the entry has no broadcaster.
+func WithBroadcaster(ctx context.Context, broadcasterUserID int) context.Context {
+	return context.WithValue(ctx, broadcasterKey{}, broadcasterUserID)
+}
+
+// BroadcasterFromContext returns the broadcaster set with WithBroadcaster.
+func BroadcasterFromContext(ctx context.Context) (int, bool) {
+	broadcasterUserID, ok := ctx.Value(broadcasterKey{}).(int)
+	return broadcasterUserID, ok
+}
+
+// RecorderConfig configures a Recorder.
+type RecorderConfig struct {
+	// Store persists the audit log. Defaults to a MemoryStore when nil.
+	Store Store
+
+	// Logger receives the errors of the store while recording API calls. Defaults to slog.Default() when nil.
+	Logger *slog.Logger
+}
+
+// Recorder records moderation actions in an audit log. It is safe for concurrent use.
+type Recorder struct {
+	store  Store
+	logger *slog.Logger
+}
+
+// NewRecorder creates a new Recorder with the provided configuration.
+func NewRecorder(config RecorderConfig) (*Recorder, error) {
+	if config.Store == nil {
+		config.Store = NewMemoryStore()
+	}
+
+	return &Recorder{
+		store:  config.Store,
+		logger: logging.OrDefault(config.Logger),
+	}, nil
+}
+
+// Record appends the entry to the log. A zero At is set to the current time.
+func (r *Recorder) Record(ctx context.Context, entry Entry) error {
+	if err := kickerrors.ValidateNotEmpty("Action", string(entry.Action)); err != nil {
+		return err
+	}
+	if entry.At.IsZero() {
+		entry.At = time.Now()
+	}
+	return r.store.Append(ctx, entry)
+}
+
+// Interceptor returns an interceptor for APIClientConfig that records every call to BanUser, TimeOutUser, Moderate,
+// UnbanUser and DeleteChatMessage, including failed calls. The moderator is the actor set on the context of the call
+// with WithActor, and the channel of a deleted message is the broadcaster set with WithBroadcaster. Errors of the
+// store are logged and never fail the call.
+func (r *Recorder) Interceptor() kickinterceptor.Interceptor {
+	return func(operation string, req *http.Request, next kickinterceptor.Invoker) (*http.Response, error) {
+		var entry Entry
+		switch operation {
+		case "Moderation.BanUser", "Moderation.TimeOutUser", "Moderation.Moderate":
+			var body kickapitypes.ModerationRequest
+			if err := peekJSON(req, &body); err != nil {
+				return next(req)
+			}
+			entry = Entry{Action: ActionBan, BroadcasterUserID: body.BroadcasterUserID, Target: Actor{UserID: body.UserID}}
+			if body.Reason != nil {
+				entry.Reason = *body.Reason
+			}
+			if body.Duration != nil {
+				entry.Action = ActionTimeout
+			}
+		case "Moderation.UnbanUser":
+			var body kickapitypes.UnBanRequest
+			if err := peekJSON(req, &body); err != nil {
+				return next(req)
+			}
+			entry = Entry{Action: ActionUnban, BroadcasterUserID: body.BroadcasterUserID, Target: Actor{UserID: body.UserID}}
+		case "Chat.DeleteChatMessage":
+			entry = Entry{Action: ActionDeleteMessage, MessageID: path.Base(req.URL.Path)}
+			entry.BroadcasterUserID, _ = BroadcasterFromContext(req.Context())
+		default:
+			return next(req)
+		}
+
+		ctx := req.Context()
+		entry.At = time.Now()
+		entry.Sources = []Source{SourceAPI}
+		entry.Moderator, _ = ActorFromContext(ctx)
+
+		resp, err := next(req)
+
+		switch {
+		case err != nil:
+			entry.Error = err.Error()
+		case resp.StatusCode < http.StatusOK || resp.StatusCode > http.StatusNoContent:
+			entry.Error = fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode))
+		case entry.Action == ActionTimeout:
+			var body kickapitypes.ModerationRequest
+			if peekJSON(req, &body) == nil && body.Duration != nil {
+				entry.ExpiresAt = entry.At.Add(time.Duration(*body.Duration) * time.Minute)
+			}
+		}
+
+		if storeErr := r.store.Append(ctx, entry); storeErr != nil {
+			r.logger.ErrorContext(ctx, "kick: recording moderation call failed",
+				slog.String("operation", operation),
+				slog.Any("error", storeErr),
+			)
+		}
+
+		return resp, err
+	}
+}
+
+// RecordBan appends a ban or timeout from a ModerationBanned webhook to the log. Query merges it with the API call
+// that caused it, if that call was recorded.
+func (r *Recorder) RecordBan(ctx context.Context, event kickwebhooktypes.ModerationBanned) error {
+	if err := kickerrors.ValidateBroadcasterUserID(event.Broadcaster.UserID); err != nil {
+		return err
+	}
+	if err := kickerrors.ValidateUserID(event.BannedUser.UserID); err != nil {
+		return err
+	}
+
+	entry := Entry{
+		At:                event.Metadata.CreatedAt.Time(),
+		Action:            ActionBan,
+		Sources:           []Source{SourceWebhook},
+		BroadcasterUserID: event.Broadcaster.UserID,
+		Moderator:         Actor{UserID: event.Moderator.UserID, Username: event.Moderator.Username},
+		Target:            Actor{UserID: event.BannedUser.UserID, Username: event.BannedUser.Username},
+		Reason:            event.Metadata.Reason,
+		ExpiresAt:         event.Metadata.ExpiresAt.Time(),
+	}
+	if !entry.ExpiresAt.IsZero() {
+		entry.Action = ActionTimeout
+	}
+
+	return r.Record(ctx, entry)
+}
+
+// WebhookHandler returns a handler for RegisterModerationBannedHandler that records every ban and timeout.
+func (r *Recorder) WebhookHandler() func(http.ResponseWriter, *http.Request, kickwebhooktypes.KickWebhookHeaders, kickwebhooktypes.ModerationBanned) {
+	return func(writer http.ResponseWriter, request *http.Request, kickHeaders kickwebhooktypes.KickWebhookHeaders, event kickwebhooktypes.ModerationBanned) {
+		if err := r.RecordBan(request.Context(), event); err != nil {
+			r.logger.ErrorContext(request.Context(), "kick: recording ban failed",
+				slog.String("message_id", kickHeaders.MessageID),
+				slog.Any("error", err),
+			)
+			writer.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		writer.WriteHeader(http.StatusOK)
+	}
+}
+
+// Query returns the entries matching the filter, oldest first. A successful ban or timeout made through the SDK
+// and the ModerationBanned webhook Kick sent for it within a minute are returned as one entry with both sources,
+// with the moderator, target username and expiry of the webhook filling in what the API call did not know.
+func (r *Recorder) Query(ctx context.Context, filter Filter) ([]Entry, error) {
+	// The moderator of an API call may only be known from its webhook and the two may lie on either side of the
+	// time range, so candidates are loaded without the moderator and with a wider range, then filtered after merging.
+	candidates := filter
+	candidates.ModeratorUserID = 0
+	if !candidates.Since.IsZero() {
+		candidates.Since = candidates.Since.Add(-mergeWindow)
+	}
+	if !candidates.Until.IsZero() {
+		candidates.Until = candidates.Until.Add(mergeWindow)
+	}
+
+	entries, err := r.store.Query(ctx, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	merged := merge(entries)
+
+	result := make([]Entry, 0, len(merged))
+	for _, entry := range merged {
+		if filter.Match(entry) {
+			result = append(result, entry)
+		}
+	}
+
+	slices.SortStableFunc(result, func(a, b Entry) int {
+		return a.At.Compare(b.At)
+	})
+	return result, nil
+}
+
+// merge folds every webhook entry into the closest unmerged API entry for the same action.
+func merge(entries []Entry) []Entry {
+	merged := make([]Entry, 0, len(entries))
+	taken := make([]bool, len(entries))
+
+	for i, webhook := range entries {
+		if !slices.Contains(webhook.Sources, SourceWebhook) || slices.Contains(webhook.Sources, SourceAPI) {
+			continue
+		}
+
+		match := -1
+		for j, call := range entries {
+			if taken[j] || !mergeable(call, webhook) {
+				continue
+			}
+			if match == -1 || absDuration(call.At.Sub(webhook.At)) < absDuration(entries[match].At.Sub(webhook.At)) {
+				match = j
+			}
+		}
+		if match == -1 {
+			continue
+		}
+
+		taken[i], taken[match] = true, true
+
+		call := &entries[match]
+		call.Sources = []Source{SourceAPI, SourceWebhook}
+		if call.Moderator.UserID == 0 {
+			call.Moderator.UserID = webhook.Moderator.UserID
+		}
+		call.Moderator.Username = cmp.Or(call.Moderator.Username, webhook.Moderator.Username)
+		call.Target.Username = cmp.Or(call.Target.Username, webhook.Target.Username)
+		call.Reason = cmp.Or(call.Reason, webhook.Reason)
+		if !webhook.ExpiresAt.IsZero() {
+			call.ExpiresAt = webhook.ExpiresAt
+		}
+	}
+
+	for i, entry := range entries {
+		if !taken[i] || slices.Contains(entry.Sources, SourceAPI) {
+			merged = append(merged, entry)
+		}
+	}
+	return merged
+}
+
+func mergeable(call, webhook Entry) bool {
+	return slices.Equal(call.Sources, []Source{SourceAPI}) &&
+		call.Error == "" &&
+		call.Action == webhook.Action &&
+		call.BroadcasterUserID == webhook.BroadcasterUserID &&
+		call.Target.UserID == webhook.Target.UserID &&
+		absDuration(call.At.Sub(webhook.At)) < mergeWindow
+}
+
+// peekJSON decodes the JSON body of the request and restores it for the next invoker.
+func peekJSON(req *http.Request, out any) error {
+	if req.GetBody == nil && req.Body == nil {
+		return io.EOF
+	}
+
+	var body []byte
+	var err error
+	if req.GetBody != nil {
+		var reader io.ReadCloser
+		if reader, err = req.GetBody(); err != nil {
+			return err
+		}
+		defer reader.Close()
+		body, err = io.ReadAll(reader)
+	} else {
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, out)
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
@@ -0,0 +1,125 @@
+package kickaudit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+)
+
+// Store persists the audit log. Implementations must be safe for concurrent use.
+type Store interface {
+	// Append adds the entry to the log.
+	Append(ctx context.Context, entry Entry) error
+
+	// Query returns the entries matching the filter in the order they were appended.
+	Query(ctx context.Context, filter Filter) ([]Entry, error)
+}
+
+// MemoryStore is a Store that keeps the log in memory.
+type MemoryStore struct {
+	mu      sync.RWMutex
+	entries []Entry
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{}
+}
+
+func (s *MemoryStore) Append(_ context.Context, entry Entry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entries = append(s.entries, entry)
+	return nil
+}
+
+func (s *MemoryStore) Query(_ context.Context, filter Filter) ([]Entry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	var entries []Entry
+	for _, entry := range s.entries {
+		if filter.Match(entry) {
+			entries = append(entries, entry)
+		}
+	}
+	return slices.Clip(entries), nil
+}
+
+// FileStore is a Store that appends the log to a file with one JSON entry per line.
+type FileStore struct {
+	mu   sync.Mutex
+	path string
+	file *os.File
+}
+
+var _ Store = (*FileStore)(nil)
+
+// NewFileStore opens the JSONL file at path for appending, creating it when it does not exist.
+func NewFileStore(path string) (*FileStore, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("could not open audit log: %w", err)
+	}
+	return &FileStore{path: path, file: file}, nil
+}
+
+func (s *FileStore) Append(_ context.Context, entry Entry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.file.Write(append(line, '\n'))
+	return err
+}
+
+// Query reads the file and returns the matching entries. Lines that are not valid entries fail the query.
+func (s *FileStore) Query(ctx context.Context, filter Filter) ([]Entry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file, err := os.Open(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read audit log: %w", err)
+	}
+	defer file.Close()
+
+	var entries []Entry
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(scanner.Bytes()) == 0 {
+			continue
+		}
+
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return nil, fmt.Errorf("could not decode audit log '%s' line %d: %w", s.path, line, err)
+		}
+		if filter.Match(entry) {
+			entries = append(entries, entry)
+		}
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return entries, scanner.Err()
+}
+
+// Close closes the file.
+func (s *FileStore) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
@@ -0,0 +1,165 @@
+package kickautomod
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/henrikah/kick-go-sdk/v2/kickmessage"
+	"github.com/henrikah/kick-go-sdk/v2/kickwebhooktypes"
+)
+
+// History is what the engine remembers about the sender of a message.
+type History struct {
+	// FirstSeen is when the engine received the first message of the sender in the channel.
+	FirstSeen time.Time
+
+	// Messages are the previous messages of the sender in the channel within EngineConfig.HistoryWindow, oldest first.
+	Messages []string
+}
+
+// Check reports whether a message violates a rule.
+type Check func(message kickwebhooktypes.ChatMessageSent, history History) bool
+
+// All matches when every check matches, e.g. a new account posting a link.
+func All(checks ...Check) Check {
+	return func(message kickwebhooktypes.ChatMessageSent, history History) bool {
+		for _, check := range checks {
+			if !check(message, history) {
+				return false
+			}
+		}
+		return len(checks) > 0
+	}
+}
+
+// BannedWords matches messages containing any of the words, ignoring case. Words only match as a whole word.
+func BannedWords(words ...string) Check {
+	quoted := make([]string, 0, len(words))
+	for _, word := range words {
+		if word = strings.TrimSpace(word); word != "" {
+			quoted = append(quoted, regexp.QuoteMeta(word))
+		}
+	}
+	if len(quoted) == 0 {
+		return never
+	}
+
+	pattern := regexp.MustCompile(`(?i)(?:^|[^\pL\pN_])(?:` + strings.Join(quoted, "|") + `)(?:$|[^\pL\pN_])`)
+	return BannedPatterns(pattern)
+}
+
+// BannedPatterns matches messages matching any of the patterns.
+func BannedPatterns(patterns ...*regexp.Regexp) Check {
+	return func(message kickwebhooktypes.ChatMessageSent, history History) bool {
+		for _, pattern := range patterns {
+			if pattern.MatchString(message.Content) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// Links matches messages with a link to a domain that is not allowed. Subdomains of an allowed domain are allowed,
+// so "kick.com" allows "www.kick.com". Without allowed domains every link matches.
+func Links(allowedDomains ...string) Check {
+	allowed := make([]string, len(allowedDomains))
+	for i, domain := range allowedDomains {
+		allowed[i] = strings.ToLower(strings.TrimPrefix(domain, "."))
+	}
+
+	return func(message kickwebhooktypes.ChatMessageSent, history History) bool {
+		for _, segment := range kickmessage.Parse(message) {
+			if segment.Type != kickmessage.SegmentURL {
+				continue
+			}
+			parsed, err := url.Parse(segment.URL)
+			if err != nil || !allowedHost(strings.ToLower(parsed.Hostname()), allowed) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func allowedHost(host string, allowed []string) bool {
+	for _, domain := range allowed {
+		if host == domain || strings.HasSuffix(host, "."+domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// CapsRatio matches messages with at least minLetters letters of which more than maxRatio are upper case.
+// Emotes are not counted.
+func CapsRatio(maxRatio float64, minLetters int) Check {
+	return func(message kickwebhooktypes.ChatMessageSent, history History) bool {
+		var letters, upper int
+		for _, segment := range kickmessage.Parse(message) {
+			if segment.Type == kickmessage.SegmentEmote {
+				continue
+			}
+			for _, r := range segment.Text {
+				if unicode.IsLetter(r) {
+					letters++
+					if unicode.IsUpper(r) {
+						upper++
+					}
+				}
+			}
+		}
+		return letters >= minLetters && letters > 0 && float64(upper)/float64(letters) > maxRatio
+	}
+}
+
+// EmoteSpam matches messages with more than maxEmotes emotes.
+func EmoteSpam(maxEmotes int) Check {
+	return func(message kickwebhooktypes.ChatMessageSent, history History) bool {
+		emotes := 0
+		for _, segment := range kickmessage.Parse(message) {
+			if segment.Type == kickmessage.SegmentEmote {
+				emotes++
+			}
+		}
+		return emotes > maxEmotes
+	}
+}
+
+// RepeatedMessages matches a message the sender already sent at least maxRepeats times within the history window.
+// Messages are compared ignoring case and surrounding whitespace.
+func RepeatedMessages(maxRepeats int) Check {
+	return func(message kickwebhooktypes.ChatMessageSent, history History) bool {
+		content := normalize(message.Content)
+		repeats := 0
+		for _, previous := range history.Messages {
+			if normalize(previous) == content {
+				repeats++
+			}
+		}
+		return repeats >= maxRepeats
+	}
+}
+
+// NewAccounts matches senders without badges that the engine first saw within the duration. The engine only knows
+// the chatters it received messages from, so after a restart every chatter is new; combine it with another check
+// using All.
+func NewAccounts(within time.Duration) Check {
+	return func(message kickwebhooktypes.ChatMessageSent, history History) bool {
+		if message.Sender.Identity != nil && len(message.Sender.Identity.Badges) > 0 {
+			return false
+		}
+		return time.Since(history.FirstSeen) < within
+	}
+}
+
+func never(kickwebhooktypes.ChatMessageSent, History) bool {
+	return false
+}
+
+func normalize(content string) string {
+	return strings.ToLower(strings.TrimSpace(content))
+}
@@ -0,0 +1,25 @@
+// Package kickautomod moderates chat automatically with rules evaluated against ChatMessageSent webhooks.
+//
+// A Rule pairs a Check, such as BannedWords, Links, CapsRatio, EmoteSpam, RepeatedMessages or NewAccounts, with
+// an Action that deletes the message, times the sender out or bans the sender. Senders with an exempt badge are
+// skipped, DryRun and per-rule Shadow mode log decisions without acting, and every decision is logged with the
+// rule that fired:
+//
+//	engine, err := kickautomod.NewEngine(kickautomod.EngineConfig{
+//		Chat:       apiClient.Chat(),
+//		Moderation: apiClient.Moderation(),
+//		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+//			return moderatorAccessToken, nil
+//		},
+//		Rules: []kickautomod.Rule{
+//			{Name: "slurs", Check: kickautomod.BannedWords("badword"), Action: kickautomod.Action{Type: kickautomod.ActionBan}},
+//			{Name: "links", Check: kickautomod.Links("kick.com"), Action: kickautomod.Action{Type: kickautomod.ActionDelete}, ExemptBadges: []string{"vip"}},
+//			{Name: "caps", Check: kickautomod.CapsRatio(0.7, 10), Action: kickautomod.Action{Type: kickautomod.ActionTimeout, Duration: time.Minute}},
+//		},
+//	})
+//	if err != nil {
+//		log.Fatalf("could not create automod: %v", err)
+//	}
+//
+//	webhookClient.RegisterChatMessageSentHandler(engine.WebhookHandler())
+package kickautomod
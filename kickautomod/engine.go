@@ -0,0 +1,322 @@
+package kickautomod
+
+import (
+	"cmp"
+	"context"
+	"log/slog"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/henrikah/kick-go-sdk/v2/internal/logging"
+	"github.com/henrikah/kick-go-sdk/v2/kickcontracts"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/kickmoderation"
+	"github.com/henrikah/kick-go-sdk/v2/kickwebhooktypes"
+)
+
+const (
+	defaultHistoryWindow = 5 * time.Minute
+	chatterRetention     = 24 * time.Hour
+	maxHistoryMessages   = 20
+)
+
+// ActionType is what the engine does with a message that violates a rule.
+type ActionType string
+
+const (
+	// ActionDelete deletes the message with DeleteChatMessage.
+	ActionDelete ActionType = "delete"
+	// ActionTimeout times the sender out with TimeOutUser.
+	ActionTimeout ActionType = "timeout"
+	// ActionBan bans the sender with BanUser.
+	ActionBan ActionType = "ban"
+)
+
+// Action is taken when a rule matches.
+type Action struct {
+	Type ActionType
+
+	// Duration of a timeout. Kick takes timeouts in whole minutes, so the duration is rounded up to a minute.
+	Duration time.Duration
+
+	// Reason is sent with timeouts and bans. Defaults to the name of the rule when empty.
+	Reason string
+}
+
+// Rule maps a check to an action.
+type Rule struct {
+	// Name identifies the rule in decisions and logs.
+	Name string
+
+	// Check decides whether a message violates the rule.
+	Check Check
+
+	// Action is taken when the check matches.
+	Action Action
+
+	// ExemptBadges are badge types exempt from the rule on top of EngineConfig.ExemptBadges.
+	ExemptBadges []string
+
+	// Shadow logs the decisions of the rule without taking the action and keeps evaluating the next rules.
+	Shadow bool
+}
+
+// Decision is the outcome of a rule matching a message.
+type Decision struct {
+	Rule              string
+	Action            Action
+	BroadcasterUserID int
+	UserID            int
+	MessageID         string
+
+	// DryRun is true when the action was not taken because of dry-run or shadow mode.
+	DryRun bool
+
+	// Err is the error of the action, nil when it succeeded or was not taken.
+	Err error
+}
+
+// EngineConfig configures an Engine.
+type EngineConfig struct {
+	// Chat is the service used to delete messages.
+	Chat kickcontracts.Chat
+
+	// Moderation is the service used to time out and ban users.
+	Moderation kickcontracts.Moderation
+
+	// AccessToken returns the access token used to moderate the channel of the broadcaster.
+	AccessToken func(ctx context.Context, broadcasterUserID int) (string, error)
+
+	// Rules are evaluated in order, the first matching rule that is not in shadow mode decides the action.
+	Rules []Rule
+
+	// ExemptBadges are badge types exempt from every rule. Defaults to broadcaster and moderator when nil.
+	// The broadcaster is always exempt.
+	ExemptBadges []string
+
+	// DryRun logs every decision without taking any action.
+	DryRun bool
+
+	// HistoryWindow is how long the messages of a chatter are remembered for RepeatedMessages.
+	// Defaults to 5 minutes when zero.
+	HistoryWindow time.Duration
+
+	// OnDecision is called with every decision after its action was taken.
+	OnDecision func(decision Decision)
+
+	// Logger receives every decision. Defaults to slog.Default() when nil.
+	Logger *slog.Logger
+}
+
+type chatterKey struct {
+	broadcasterUserID int
+	userID            int
+}
+
+type chatter struct {
+	firstSeen time.Time
+	lastSeen  time.Time
+	messages  []string
+	times     []time.Time
+}
+
+// Engine moderates chat messages with a list of rules. It is safe for concurrent use.
+type Engine struct {
+	config    EngineConfig
+	logger    *slog.Logger
+	mu        sync.Mutex
+	chatters  map[chatterKey]*chatter
+	lastPrune time.Time
+}
+
+// NewEngine creates a new Engine with the provided configuration.
+func NewEngine(config EngineConfig) (*Engine, error) {
+	if err := kickerrors.ValidateNotNil("AccessToken", config.AccessToken); err != nil {
+		return nil, err
+	}
+	if err := kickerrors.ValidateMinItems("Rules", config.Rules, 1); err != nil {
+		return nil, err
+	}
+	for _, rule := range config.Rules {
+		if err := kickerrors.ValidateNotEmpty("Rules.Name", rule.Name); err != nil {
+			return nil, err
+		}
+		if err := kickerrors.ValidateNotNil("Rules.Check", rule.Check); err != nil {
+			return nil, err
+		}
+		switch rule.Action.Type {
+		case ActionDelete:
+			if err := kickerrors.ValidateNotNil("Chat", config.Chat); err != nil {
+				return nil, err
+			}
+		case ActionTimeout:
+			if err := kickmoderation.ValidateTimeout("Rules.Action.Duration", rule.Action.Duration); err != nil {
+				return nil, err
+			}
+			fallthrough
+		case ActionBan:
+			if err := kickerrors.ValidateNotNil("Moderation", config.Moderation); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, &kickerrors.ValidationError{Field: "Rules.Action.Type", Message: "must be delete, timeout or ban"}
+		}
+	}
+
+	if config.ExemptBadges == nil {
+		config.ExemptBadges = []string{"broadcaster", "moderator"}
+	}
+	config.HistoryWindow = cmp.Or(config.HistoryWindow, defaultHistoryWindow)
+
+	return &Engine{
+		config:   config,
+		logger:   logging.OrDefault(config.Logger),
+		chatters: make(map[chatterKey]*chatter),
+	}, nil
+}
+
+// Handle evaluates the rules against the message and takes the action of the first matching rule. It returns the
+// decisions of every matching rule, shadow rules included, and the error of the action that was taken.
+func (e *Engine) Handle(ctx context.Context, message kickwebhooktypes.ChatMessageSent) ([]Decision, error) {
+	if message.Sender.UserID != 0 && message.Sender.UserID == message.Broadcaster.UserID {
+		return nil, nil
+	}
+
+	history := e.record(message, time.Now())
+
+	var decisions []Decision
+	for _, rule := range e.config.Rules {
+		if e.exempt(message, rule) || !rule.Check(message, history) {
+			continue
+		}
+
+		decision := Decision{
+			Rule:              rule.Name,
+			Action:            rule.Action,
+			BroadcasterUserID: message.Broadcaster.UserID,
+			UserID:            message.Sender.UserID,
+			MessageID:         message.MessageID,
+			DryRun:            e.config.DryRun || rule.Shadow,
+		}
+		decision.Action.Reason = cmp.Or(decision.Action.Reason, rule.Name)
+
+		if !decision.DryRun {
+			decision.Err = e.act(ctx, decision)
+		}
+		e.log(ctx, decision)
+		if e.config.OnDecision != nil {
+			e.config.OnDecision(decision)
+		}
+		decisions = append(decisions, decision)
+
+		if !rule.Shadow {
+			return decisions, decision.Err
+		}
+	}
+
+	return decisions, nil
+}
+
+// WebhookHandler returns a handler for RegisterChatMessageSentHandler that moderates every message.
+// Kick always receives status 200, errors are logged with the decision.
+func (e *Engine) WebhookHandler() func(http.ResponseWriter, *http.Request, kickwebhooktypes.KickWebhookHeaders, kickwebhooktypes.ChatMessageSent) {
+	return func(writer http.ResponseWriter, request *http.Request, kickHeaders kickwebhooktypes.KickWebhookHeaders, message kickwebhooktypes.ChatMessageSent) {
+		_, _ = e.Handle(request.Context(), message)
+		writer.WriteHeader(http.StatusOK)
+	}
+}
+
+func (e *Engine) exempt(message kickwebhooktypes.ChatMessageSent, rule Rule) bool {
+	if message.Sender.Identity == nil {
+		return false
+	}
+	for _, badge := range message.Sender.Identity.Badges {
+		if slices.Contains(e.config.ExemptBadges, badge.Type) || slices.Contains(rule.ExemptBadges, badge.Type) {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *Engine) act(ctx context.Context, decision Decision) error {
+	accessToken, err := e.config.AccessToken(ctx, decision.BroadcasterUserID)
+	if err != nil {
+		return err
+	}
+
+	reason := decision.Action.Reason
+
+	switch decision.Action.Type {
+	case ActionDelete:
+		return e.config.Chat.DeleteChatMessage(ctx, accessToken, decision.MessageID)
+	case ActionTimeout:
+		_, err = e.config.Moderation.Moderate(ctx, accessToken, kickmoderation.NewTimeout(decision.BroadcasterUserID, decision.UserID, decision.Action.Duration).WithReason(reason))
+	case ActionBan:
+		_, err = e.config.Moderation.BanUser(ctx, accessToken, decision.BroadcasterUserID, decision.UserID, &reason)
+	}
+	return err
+}
+
+func (e *Engine) log(ctx context.Context, decision Decision) {
+	attrs := []any{
+		slog.String("rule", decision.Rule),
+		slog.String("action", string(decision.Action.Type)),
+		slog.Int("broadcaster_user_id", decision.BroadcasterUserID),
+		slog.Int("user_id", decision.UserID),
+		slog.String("message_id", decision.MessageID),
+		slog.Bool("dry_run", decision.DryRun),
+	}
+	if decision.Action.Type == ActionTimeout {
+		attrs = append(attrs, slog.Duration("duration", decision.Action.Duration))
+	}
+
+	if decision.Err != nil {
+		e.logger.ErrorContext(ctx, "kick: automod action failed", append(attrs, slog.Any("error", decision.Err))...)
+		return
+	}
+	e.logger.InfoContext(ctx, "kick: automod decision", attrs...)
+}
+
+// record adds the message to the history of the sender and returns the history before the message.
+func (e *Engine) record(message kickwebhooktypes.ChatMessageSent, now time.Time) History {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if now.Sub(e.lastPrune) > e.config.HistoryWindow {
+		for key, c := range e.chatters {
+			if now.Sub(c.lastSeen) > chatterRetention {
+				delete(e.chatters, key)
+			}
+		}
+		e.lastPrune = now
+	}
+
+	key := chatterKey{broadcasterUserID: message.Broadcaster.UserID, userID: message.Sender.UserID}
+	c, ok := e.chatters[key]
+	if !ok {
+		c = &chatter{firstSeen: now}
+		e.chatters[key] = c
+	}
+	c.lastSeen = now
+
+	keep := 0
+	for keep < len(c.times) && now.Sub(c.times[keep]) > e.config.HistoryWindow {
+		keep++
+	}
+	c.messages, c.times = c.messages[keep:], c.times[keep:]
+
+	history := History{
+		FirstSeen: c.firstSeen,
+		Messages:  slices.Clone(c.messages),
+	}
+
+	c.messages = append(c.messages, message.Content)
+	c.times = append(c.times, now)
+	if len(c.messages) > maxHistoryMessages {
+		c.messages, c.times = c.messages[1:], c.times[1:]
+	}
+
+	return history
+}
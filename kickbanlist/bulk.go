@@ -0,0 +1,261 @@
+package kickbanlist
+
+import (
+	"cmp"
+	"context"
+	"sync"
+	"time"
+
+	"github.com/henrikah/kick-go-sdk/v2/internal/batch"
+	"github.com/henrikah/kick-go-sdk/v2/internal/ratelimit"
+	"github.com/henrikah/kick-go-sdk/v2/kickcontracts"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/kickmoderation"
+)
+
+const defaultInterval = 100 * time.Millisecond
+
+// Action is what a bulk operation did for a target.
+type Action string
+
+const (
+	// ActionBan banned the user permanently.
+	ActionBan Action = "ban"
+	// ActionTimeout timed the user out until the expiry of the entry.
+	ActionTimeout Action = "timeout"
+	// ActionUnban lifted the ban of the user.
+	ActionUnban Action = "unban"
+	// ActionSkip did nothing because the entry already expired.
+	ActionSkip Action = "skip"
+)
+
+// Result is the outcome for one user in one channel.
+type Result struct {
+	BroadcasterUserID int
+	UserID            int
+	Action            Action
+
+	// Err is the error of the action, nil when it succeeded.
+	Err error
+}
+
+// Report lists the result of every target in the order of the channels and then the entries.
+type Report struct {
+	Results []Result
+}
+
+// Succeeded returns the number of actions that succeeded, skipped targets excluded.
+func (r *Report) Succeeded() int {
+	succeeded := 0
+	for _, result := range r.Results {
+		if result.Err == nil && result.Action != ActionSkip {
+			succeeded++
+		}
+	}
+	return succeeded
+}
+
+// Failed returns the results of the actions that failed.
+func (r *Report) Failed() []Result {
+	var failed []Result
+	for _, result := range r.Results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// BulkConfig configures a Bulk.
+type BulkConfig struct {
+	// Moderation is the service used to ban, time out and unban users.
+	Moderation kickcontracts.Moderation
+
+	// AccessToken returns the access token used to moderate the channel of the broadcaster.
+	AccessToken func(ctx context.Context, broadcasterUserID int) (string, error)
+
+	// Concurrency limits how many requests are in flight. Defaults to 4 when zero.
+	Concurrency int
+
+	// Interval is the minimum time between two requests across all channels. Defaults to 100 milliseconds when zero.
+	Interval time.Duration
+}
+
+// Bulk applies ban lists to many channels.
+type Bulk struct {
+	config  BulkConfig
+	limiter *ratelimit.Limiter
+}
+
+// NewBulk creates a new Bulk with the provided configuration.
+func NewBulk(config BulkConfig) (*Bulk, error) {
+	if err := kickerrors.ValidateNotNil("Moderation", config.Moderation); err != nil {
+		return nil, err
+	}
+	if err := kickerrors.ValidateNotNil("AccessToken", config.AccessToken); err != nil {
+		return nil, err
+	}
+
+	config.Concurrency = cmp.Or(config.Concurrency, batch.DefaultConcurrency)
+	config.Interval = cmp.Or(config.Interval, defaultInterval)
+
+	return &Bulk{
+		config:  config,
+		limiter: ratelimit.New(config.Interval),
+	}, nil
+}
+
+type target struct {
+	broadcasterUserID int
+	entry             Entry
+}
+
+// Ban applies the ban list to every channel. Entries without expiry are banned, entries expiring in the future
+// are timed out until their expiry and expired entries are skipped. Entries expiring within a minute are timed out
+// for a minute, the shortest timeout Kick supports. Expiries more than 7 days away fail with a
+// *kickerrors.ValidationError because Kick does not support longer timeouts.
+//
+// The report is always returned, together with a *kickerrors.BulkModerationError when some actions failed.
+func (b *Bulk) Ban(ctx context.Context, broadcasterUserIDs []int, list List) (*Report, error) {
+	if err := b.validate(broadcasterUserIDs); err != nil {
+		return nil, err
+	}
+	if err := list.validate(); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	plan := func(target target) (Action, error) {
+		if target.entry.ExpiresAt.IsZero() {
+			return ActionBan, nil
+		}
+		remaining := target.entry.ExpiresAt.Sub(now)
+		if remaining <= 0 {
+			return ActionSkip, nil
+		}
+		if remaining > kickmoderation.MaxTimeout {
+			return ActionTimeout, &kickerrors.ValidationError{Field: "ExpiresAt", Message: "must be at most 7 days away"}
+		}
+		return ActionTimeout, nil
+	}
+
+	return b.run(ctx, targets(broadcasterUserIDs, list), plan, func(ctx context.Context, accessToken string, target target, action Action) error {
+		request := kickmoderation.NewBan(target.broadcasterUserID, target.entry.UserID)
+		if action == ActionTimeout {
+			remaining := max(target.entry.ExpiresAt.Sub(now), time.Minute)
+			request = kickmoderation.NewTimeout(target.broadcasterUserID, target.entry.UserID, remaining)
+		}
+		if target.entry.Reason != "" {
+			request.WithReason(target.entry.Reason)
+		}
+
+		_, err := b.config.Moderation.Moderate(ctx, accessToken, request)
+		return err
+	})
+}
+
+// Unban lifts the ban of every user in every channel.
+//
+// The report is always returned, together with a *kickerrors.BulkModerationError when some actions failed.
+func (b *Bulk) Unban(ctx context.Context, broadcasterUserIDs []int, userIDs []int) (*Report, error) {
+	if err := b.validate(broadcasterUserIDs); err != nil {
+		return nil, err
+	}
+
+	list := make(List, len(userIDs))
+	for i, userID := range userIDs {
+		list[i] = Entry{UserID: userID}
+	}
+	if err := list.validate(); err != nil {
+		return nil, err
+	}
+
+	plan := func(target) (Action, error) {
+		return ActionUnban, nil
+	}
+
+	return b.run(ctx, targets(broadcasterUserIDs, list), plan, func(ctx context.Context, accessToken string, target target, _ Action) error {
+		_, err := b.config.Moderation.UnbanUser(ctx, accessToken, target.broadcasterUserID, target.entry.UserID)
+		return err
+	})
+}
+
+func (b *Bulk) validate(broadcasterUserIDs []int) error {
+	if err := kickerrors.ValidateMinItems("broadcasterUserIDs", broadcasterUserIDs, 1); err != nil {
+		return err
+	}
+	for _, broadcasterUserID := range broadcasterUserIDs {
+		if err := kickerrors.ValidateBroadcasterUserID(broadcasterUserID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// run plans the action of every target and applies it with bounded concurrency and pacing.
+func (b *Bulk) run(ctx context.Context, targets []target, plan func(target target) (Action, error), apply func(ctx context.Context, accessToken string, target target, action Action) error) (*Report, error) {
+	report := &Report{Results: make([]Result, len(targets))}
+
+	semaphore := make(chan struct{}, b.config.Concurrency)
+	var wg sync.WaitGroup
+
+	for i, target := range targets {
+		result := &report.Results[i]
+		result.BroadcasterUserID, result.UserID = target.broadcasterUserID, target.entry.UserID
+
+		if result.Action, result.Err = plan(target); result.Err != nil || result.Action == ActionSkip {
+			continue
+		}
+
+		select {
+		case semaphore <- struct{}{}:
+		case <-ctx.Done():
+			result.Err = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			accessToken, err := b.config.AccessToken(ctx, target.broadcasterUserID)
+			if err == nil {
+				err = b.limiter.Wait(ctx)
+			}
+			if err == nil {
+				err = apply(ctx, accessToken, target, result.Action)
+			}
+			result.Err = err
+		}()
+	}
+	wg.Wait()
+
+	var failed []kickerrors.TargetError
+	for _, result := range report.Results {
+		if result.Err != nil {
+			failed = append(failed, kickerrors.TargetError{
+				Action:            string(result.Action),
+				BroadcasterUserID: result.BroadcasterUserID,
+				UserID:            result.UserID,
+				Err:               result.Err,
+			})
+		}
+	}
+
+	if len(failed) > 0 {
+		return report, kickerrors.SetBulkModerationError(len(targets), failed)
+	}
+
+	return report, nil
+}
+
+func targets(broadcasterUserIDs []int, list List) []target {
+	targets := make([]target, 0, len(broadcasterUserIDs)*len(list))
+	for _, broadcasterUserID := range broadcasterUserIDs {
+		for _, entry := range list {
+			targets = append(targets, target{broadcasterUserID: broadcasterUserID, entry: entry})
+		}
+	}
+	return targets
+}
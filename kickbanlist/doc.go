@@ -0,0 +1,35 @@
+// Package kickbanlist applies shared ban lists to many channels.
+//
+// A List holds users with an optional reason and expiry and reads and writes as JSON or CSV. Bulk bans or unbans
+// every user of a list in every channel with bounded concurrency and pacing, and reports the result of every
+// (channel, user) target:
+//
+//	file, err := os.Open("banlist.csv")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	defer file.Close()
+//
+//	list, err := kickbanlist.ReadCSV(file)
+//	if err != nil {
+//		log.Fatalf("could not read ban list: %v", err)
+//	}
+//
+//	bulk, err := kickbanlist.NewBulk(kickbanlist.BulkConfig{
+//		Moderation: apiClient.Moderation(),
+//		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+//			return tokens[broadcasterUserID], nil
+//		},
+//	})
+//	if err != nil {
+//		log.Fatalf("could not create bulk: %v", err)
+//	}
+//
+//	report, err := bulk.Ban(ctx, []int{12345, 67890}, list)
+//	if bulkErr := kickerrors.IsBulkModerationError(err); bulkErr != nil {
+//		log.Printf("%d of %d bans failed", len(bulkErr.Failed), bulkErr.TotalTargets)
+//	} else if err != nil {
+//		log.Fatalf("could not apply ban list: %v", err)
+//	}
+//	log.Printf("applied %d bans", report.Succeeded())
+package kickbanlist
@@ -0,0 +1,131 @@
+package kickbanlist
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+)
+
+// csvHeader is the header row of a CSV ban list.
+var csvHeader = []string{"user_id", "username", "reason", "expires_at"}
+
+// Entry is a user on a ban list.
+type Entry struct {
+	UserID int `json:"user_id"`
+
+	// Username is informational, the ban list is applied by user ID.
+	Username string `json:"username,omitempty"`
+
+	// Reason is sent with the ban or timeout.
+	Reason string `json:"reason,omitempty"`
+
+	// ExpiresAt ends the ban. A zero time bans permanently, a time in the future times the user out until then.
+	ExpiresAt time.Time `json:"expires_at,omitzero"`
+}
+
+// List is a ban list shared across channels.
+type List []Entry
+
+// ReadJSON reads a ban list written by WriteJSON: a JSON array of entries.
+func ReadJSON(r io.Reader) (List, error) {
+	var list List
+	if err := json.NewDecoder(r).Decode(&list); err != nil {
+		return nil, err
+	}
+	return list, list.validate()
+}
+
+// WriteJSON writes the ban list as an indented JSON array of entries.
+func (l List) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if l == nil {
+		l = List{}
+	}
+	return encoder.Encode(l)
+}
+
+// ReadCSV reads a ban list written by WriteCSV. The first row must be the header
+// "user_id,username,reason,expires_at"; expires_at is RFC 3339 and empty for permanent bans.
+func ReadCSV(r io.Reader) (List, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = len(csvHeader)
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+	for i, column := range csvHeader {
+		if strings.TrimSpace(strings.TrimPrefix(header[i], "\ufeff")) != column {
+			return nil, fmt.Errorf("kickbanlist: expected column %q in header, got %q", column, header[i])
+		}
+	}
+
+	var list List
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+
+		userID, err := strconv.Atoi(strings.TrimSpace(record[0]))
+		if err != nil {
+			return nil, fmt.Errorf("kickbanlist: line %d: invalid user_id %q", line, record[0])
+		}
+
+		entry := Entry{UserID: userID, Username: record[1], Reason: record[2]}
+		if expiresAt := strings.TrimSpace(record[3]); expiresAt != "" {
+			if entry.ExpiresAt, err = time.Parse(time.RFC3339, expiresAt); err != nil {
+				return nil, fmt.Errorf("kickbanlist: line %d: invalid expires_at %q", line, record[3])
+			}
+		}
+		list = append(list, entry)
+	}
+
+	return list, list.validate()
+}
+
+// WriteCSV writes the ban list as CSV with a header row.
+func (l List) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, entry := range l {
+		var expiresAt string
+		if !entry.ExpiresAt.IsZero() {
+			expiresAt = entry.ExpiresAt.UTC().Format(time.RFC3339)
+		}
+		if err := writer.Write([]string{strconv.Itoa(entry.UserID), entry.Username, entry.Reason, expiresAt}); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func (l List) validate() error {
+	for i, entry := range l {
+		if entry.UserID <= 0 {
+			return &kickerrors.ValidationError{
+				Field:   "UserID",
+				Message: fmt.Sprintf("must be 1 or higher, got %d in entry %d", entry.UserID, i),
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+package kickcache
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickcontracts"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/kickwebhooktypes"
+)
+
+const (
+	defaultUserTTL     = 10 * time.Minute
+	defaultChannelTTL  = time.Minute
+	defaultCategoryTTL = time.Hour
+	defaultNegativeTTL = time.Minute
+	defaultMaxEntries  = 10000
+
+	userPrefix        = "user:"
+	channelPrefix     = "channel:id:"
+	channelSlugPrefix = "channel:slug:"
+	categoryPrefix    = "category:"
+	categoryIDPrefix  = "category:id:"
+)
+
+// Config configures a Cache. Zero values fall back to the defaults.
+type Config struct {
+	// UserTTL is how long users are cached. Defaults to 10 minutes.
+	UserTTL time.Duration
+
+	// ChannelTTL is how long channels are cached. Defaults to 1 minute.
+	ChannelTTL time.Duration
+
+	// CategoryTTL is how long categories are cached. Defaults to 1 hour.
+	CategoryTTL time.Duration
+
+	// NegativeTTL is how long IDs that the API did not return are remembered as missing. Defaults to 1 minute.
+	NegativeTTL time.Duration
+
+	// MaxEntries bounds the number of cached entries across all types. Defaults to 10000.
+	MaxEntries int
+
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// Cache holds the entries shared by the cached User, Channel and Category services.
+type Cache struct {
+	config Config
+	store  *lru
+	flight flightGroup
+}
+
+// New creates a new Cache with the provided configuration.
+func New(config Config) *Cache {
+	if config.UserTTL <= 0 {
+		config.UserTTL = defaultUserTTL
+	}
+	if config.ChannelTTL <= 0 {
+		config.ChannelTTL = defaultChannelTTL
+	}
+	if config.CategoryTTL <= 0 {
+		config.CategoryTTL = defaultCategoryTTL
+	}
+	if config.NegativeTTL <= 0 {
+		config.NegativeTTL = defaultNegativeTTL
+	}
+	if config.MaxEntries <= 0 {
+		config.MaxEntries = defaultMaxEntries
+	}
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+
+	return &Cache{
+		config: config,
+		store:  newLRU(config.MaxEntries),
+	}
+}
+
+// User wraps the User service with the cache.
+//
+// Unlike the wrapped service, the cached users never include an email, not even the one of the owner
+// of the access token. Use GetCurrentUser, which is not cached, to read the owner's email.
+func (c *Cache) User(next kickcontracts.User) kickcontracts.User {
+	return &userCache{cache: c, next: next}
+}
+
+// Channel wraps the Channel service with the cache.
+//
+// Unlike the wrapped service, the cached channels never include a stream key.
+func (c *Cache) Channel(next kickcontracts.Channel) kickcontracts.Channel {
+	return &channelCache{cache: c, next: next}
+}
+
+// Category wraps the Category service with the cache.
+func (c *Cache) Category(next kickcontracts.Category) kickcontracts.Category {
+	return &categoryCache{cache: c, next: next}
+}
+
+// Len returns the number of entries currently held, including expired entries not yet evicted.
+func (c *Cache) Len() int {
+	return c.store.len()
+}
+
+// Purge removes every entry from the cache.
+func (c *Cache) Purge() {
+	c.store.deletePrefix("")
+}
+
+// InvalidateUser removes the cached user.
+func (c *Cache) InvalidateUser(userID int64) {
+	c.store.delete(userPrefix + fmt.Sprint(userID))
+}
+
+// InvalidateChannel removes the cached channel of the broadcaster, including its slug entry.
+func (c *Cache) InvalidateChannel(broadcasterUserID int64) {
+	key := channelPrefix + fmt.Sprint(broadcasterUserID)
+	if cached, ok := c.store.get(key, c.config.Now()); ok && cached.found {
+		c.store.delete(channelSlugPrefix + strings.ToLower(cached.value.(kickapitypes.ChannelData).Slug))
+	}
+	c.store.delete(key)
+}
+
+// InvalidateChannelSlug removes the cached channel with the slug, including its broadcaster user ID entry.
+func (c *Cache) InvalidateChannelSlug(slug string) {
+	key := channelSlugPrefix + strings.ToLower(slug)
+	if cached, ok := c.store.get(key, c.config.Now()); ok && cached.found {
+		c.store.delete(channelPrefix + fmt.Sprint(cached.value.(kickapitypes.ChannelData).BroadcasterUserID))
+	}
+	c.store.delete(key)
+}
+
+// InvalidateCategories removes every cached category search.
+func (c *Cache) InvalidateCategories() {
+	c.store.deletePrefix(categoryPrefix)
+}
+
+// OnLivestreamMetadataUpdated drops the broadcaster's channel since its title and category changed.
+func (c *Cache) OnLivestreamMetadataUpdated(event kickwebhooktypes.LivestreamMetadataUpdated) {
+	c.invalidateBroadcaster(event.Broadcaster)
+}
+
+// OnLivestreamStatusUpdated drops the broadcaster's channel since its stream went live or offline.
+func (c *Cache) OnLivestreamStatusUpdated(event kickwebhooktypes.LivestreamStatusUpdated) {
+	c.invalidateBroadcaster(event.Broadcaster)
+}
+
+func (c *Cache) invalidateBroadcaster(broadcaster kickwebhooktypes.User) {
+	if broadcaster.UserID > 0 {
+		c.InvalidateChannel(int64(broadcaster.UserID))
+		c.InvalidateUser(int64(broadcaster.UserID))
+	}
+	if broadcaster.ChannelSlug != "" {
+		c.InvalidateChannelSlug(broadcaster.ChannelSlug)
+	}
+}
+
+type fetched[K comparable, V any] struct {
+	values  map[K]V
+	message string
+}
+
+// resolve serves keys from the cache and fetches the misses with a single coalesced call.
+//
+// fetch may return a partial result together with a *kickerrors.BatchError, keys in failed chunks
+// are neither cached as missing nor returned.
+func resolve[K comparable, V any](ctx context.Context, c *Cache, prefix string, ttl time.Duration, keys []K, keyOf func(K) string, fetch func(ctx context.Context, misses []K) (map[K]V, string, error)) ([]V, string, error) {
+	now := c.config.Now()
+
+	unique := make([]K, 0, len(keys))
+	seen := make(map[K]bool, len(keys))
+	cached := make(map[K]V, len(keys))
+	var misses []K
+
+	for _, key := range keys {
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		unique = append(unique, key)
+
+		hit, ok := c.store.get(prefix+keyOf(key), now)
+		if !ok {
+			misses = append(misses, key)
+			continue
+		}
+		if hit.found {
+			cached[key] = hit.value.(V)
+		}
+	}
+
+	var message string
+	var err error
+
+	if len(misses) > 0 {
+		missKeys := make([]string, len(misses))
+		for i, miss := range misses {
+			missKeys[i] = keyOf(miss)
+		}
+
+		result, flightErr := c.flight.do(ctx, prefix+strings.Join(missKeys, ","), func(ctx context.Context) (any, error) {
+			values, fetchMessage, fetchErr := fetch(ctx, misses)
+
+			batchErr := kickerrors.IsBatchError(fetchErr)
+			if fetchErr != nil && batchErr == nil {
+				return nil, fetchErr
+			}
+
+			failed := make(map[K]bool)
+			if batchErr != nil {
+				for _, chunk := range batchErr.Failed {
+					for _, miss := range misses[chunk.Offset:min(chunk.Offset+chunk.Size, len(misses))] {
+						failed[miss] = true
+					}
+				}
+			}
+
+			storedAt := c.config.Now()
+			for _, miss := range misses {
+				if failed[miss] {
+					continue
+				}
+				if value, ok := values[miss]; ok {
+					c.store.set(prefix+keyOf(miss), value, true, storedAt.Add(ttl))
+				} else {
+					c.store.set(prefix+keyOf(miss), nil, false, storedAt.Add(c.config.NegativeTTL))
+				}
+			}
+
+			return fetched[K, V]{values: values, message: fetchMessage}, fetchErr
+		})
+		if result == nil {
+			return nil, "", flightErr
+		}
+
+		data := result.(fetched[K, V])
+		for key, value := range data.values {
+			cached[key] = value
+		}
+		message = data.message
+		err = flightErr
+	}
+
+	values := make([]V, 0, len(unique))
+	for _, key := range unique {
+		if value, ok := cached[key]; ok {
+			values = append(values, value)
+		}
+	}
+
+	return values, message, err
+}
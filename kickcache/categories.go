@@ -0,0 +1,127 @@
+package kickcache
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"github.com/henrikah/kick-go-sdk/v2/internal/endpoints"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickcontracts"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/kickfilters"
+)
+
+type categoryCache struct {
+	cache *Cache
+	next  kickcontracts.Category
+}
+
+// SearchCategories caches the response per query string, empty results are cached for the negative TTL.
+func (c *categoryCache) SearchCategories(ctx context.Context, accessToken string, filters kickfilters.CategoriesFilter) (*kickapitypes.GetCategoriesResponse, error) {
+	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
+		return nil, err
+	}
+	query := ""
+	if filters != nil {
+		queryParams, err := filters.ToQueryString()
+		if err != nil {
+			return nil, err
+		}
+		query = queryParams.Encode()
+	}
+
+	key := categoryPrefix + "search:" + query
+	if hit, ok := c.cache.store.get(key, c.cache.config.Now()); ok {
+		return cloneCategories(hit.value.(kickapitypes.GetCategoriesResponse)), nil
+	}
+
+	result, err := c.cache.flight.do(ctx, key, func(ctx context.Context) (any, error) {
+		categoriesData, err := c.next.SearchCategories(ctx, accessToken, filters)
+		if err != nil {
+			return nil, err
+		}
+
+		ttl := c.cache.config.CategoryTTL
+		if len(categoriesData.Data) == 0 {
+			ttl = c.cache.config.NegativeTTL
+		}
+		c.cache.store.set(key, *categoriesData, true, c.cache.config.Now().Add(ttl))
+
+		return *categoriesData, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return cloneCategories(result.(kickapitypes.GetCategoriesResponse)), nil
+}
+
+func cloneCategories(response kickapitypes.GetCategoriesResponse) *kickapitypes.GetCategoriesResponse {
+	response.Data = slices.Clone(response.Data)
+	return &response
+}
+
+// GetCategoryByID serves the category from the cache, a category that does not exist is cached for the negative TTL
+// and reported with the same not found API error.
+func (c *categoryCache) GetCategoryByID(ctx context.Context, accessToken string, categoryID int) (*kickapitypes.GetCategoryResponse, error) {
+	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
+		return nil, err
+	}
+	if err := kickerrors.ValidateCategoryID(categoryID); err != nil {
+		return nil, err
+	}
+
+	categories, message, err := resolve(ctx, c.cache, categoryIDPrefix, c.cache.config.CategoryTTL, []int{categoryID}, strconv.Itoa, func(ctx context.Context, misses []int) (map[int]kickapitypes.Category, string, error) {
+		categoryData, err := c.next.GetCategoryByID(ctx, accessToken, misses[0])
+		if apiErr := kickerrors.IsAPIError(err); apiErr != nil && apiErr.StatusCode == http.StatusNotFound {
+			return nil, "", nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		return map[int]kickapitypes.Category{misses[0]: categoryData.Data}, categoryData.Message, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(categories) == 0 {
+		return nil, kickerrors.SetAPIError(http.StatusNotFound, "category not found", endpoints.ViewCategoryDetailsURL(categoryID))
+	}
+
+	return &kickapitypes.GetCategoryResponse{
+		Data:    categories[0],
+		Message: message,
+	}, nil
+}
+
+func (c *categoryCache) GetCategoriesByID(ctx context.Context, accessToken string, categoryIDs []int) (*kickapitypes.GetCategoriesByIDResponse, error) {
+	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
+		return nil, err
+	}
+	if len(categoryIDs) == 0 {
+		return c.next.GetCategoriesByID(ctx, accessToken, categoryIDs)
+	}
+
+	categories, message, err := resolve(ctx, c.cache, categoryIDPrefix, c.cache.config.CategoryTTL, categoryIDs, strconv.Itoa, func(ctx context.Context, misses []int) (map[int]kickapitypes.Category, string, error) {
+		categoriesData, err := c.next.GetCategoriesByID(ctx, accessToken, misses)
+		if categoriesData == nil {
+			return nil, "", err
+		}
+
+		found := make(map[int]kickapitypes.Category, len(categoriesData.Data))
+		for _, category := range categoriesData.Data {
+			found[category.ID] = category
+		}
+		return found, categoriesData.Message, err
+	})
+	if categories == nil {
+		return nil, err
+	}
+
+	return &kickapitypes.GetCategoriesByIDResponse{
+		Data:    categories,
+		Message: message,
+	}, err
+}
@@ -0,0 +1,148 @@
+package kickcache
+
+import (
+	"context"
+	"strings"
+
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickcontracts"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+)
+
+type channelCache struct {
+	cache *Cache
+	next  kickcontracts.Channel
+}
+
+func (c *channelCache) GetChannelsByBroadcasterUserID(ctx context.Context, accessToken string, broadcasterUserIDs []int64) (*kickapitypes.Channels, error) {
+	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
+		return nil, err
+	}
+	if len(broadcasterUserIDs) == 0 {
+		return c.next.GetChannelsByBroadcasterUserID(ctx, accessToken, broadcasterUserIDs)
+	}
+	return c.getChannelsByID(ctx, broadcasterUserIDs, func(ctx context.Context, misses []int64) (*kickapitypes.Channels, error) {
+		return c.next.GetChannelsByBroadcasterUserID(ctx, accessToken, misses)
+	})
+}
+
+func (c *channelCache) GetAllChannelsByBroadcasterUserID(ctx context.Context, accessToken string, broadcasterUserIDs []int64) (*kickapitypes.Channels, error) {
+	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
+		return nil, err
+	}
+	if len(broadcasterUserIDs) == 0 {
+		return c.next.GetAllChannelsByBroadcasterUserID(ctx, accessToken, broadcasterUserIDs)
+	}
+	return c.getChannelsByID(ctx, broadcasterUserIDs, func(ctx context.Context, misses []int64) (*kickapitypes.Channels, error) {
+		return c.next.GetAllChannelsByBroadcasterUserID(ctx, accessToken, misses)
+	})
+}
+
+func (c *channelCache) GetChannelByBroadcasterUserID(ctx context.Context, accessToken string, broadcasterUserID int64) (*kickapitypes.Channels, error) {
+	return c.GetChannelsByBroadcasterUserID(ctx, accessToken, []int64{broadcasterUserID})
+}
+
+// GetCurrentBroadcasterChannel is not cached as the result depends on the access token.
+func (c *channelCache) GetCurrentBroadcasterChannel(ctx context.Context, accessToken string) (*kickapitypes.Channels, error) {
+	return c.next.GetCurrentBroadcasterChannel(ctx, accessToken)
+}
+
+func (c *channelCache) GetChannelsByBroadcasterSlug(ctx context.Context, accessToken string, slugs []string) (*kickapitypes.Channels, error) {
+	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
+		return nil, err
+	}
+	if len(slugs) == 0 {
+		return c.next.GetChannelsByBroadcasterSlug(ctx, accessToken, slugs)
+	}
+	return c.getChannelsBySlug(ctx, slugs, func(ctx context.Context, misses []string) (*kickapitypes.Channels, error) {
+		return c.next.GetChannelsByBroadcasterSlug(ctx, accessToken, misses)
+	})
+}
+
+func (c *channelCache) GetAllChannelsByBroadcasterSlug(ctx context.Context, accessToken string, slugs []string) (*kickapitypes.Channels, error) {
+	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
+		return nil, err
+	}
+	if len(slugs) == 0 {
+		return c.next.GetAllChannelsByBroadcasterSlug(ctx, accessToken, slugs)
+	}
+	return c.getChannelsBySlug(ctx, slugs, func(ctx context.Context, misses []string) (*kickapitypes.Channels, error) {
+		return c.next.GetAllChannelsByBroadcasterSlug(ctx, accessToken, misses)
+	})
+}
+
+// UpdateChannel updates the channel and drops every cached channel, as the broadcaster is only known through the access token.
+func (c *channelCache) UpdateChannel(ctx context.Context, accessToken string, updateChannelData kickapitypes.UpdateChannelRequest) error {
+	if err := c.next.UpdateChannel(ctx, accessToken, updateChannelData); err != nil {
+		return err
+	}
+
+	c.cache.store.deletePrefix(channelPrefix)
+	c.cache.store.deletePrefix(channelSlugPrefix)
+
+	return nil
+}
+
+func (c *channelCache) getChannelsByID(ctx context.Context, broadcasterUserIDs []int64, fetch func(context.Context, []int64) (*kickapitypes.Channels, error)) (*kickapitypes.Channels, error) {
+	channels, message, err := resolve(ctx, c.cache, channelPrefix, c.cache.config.ChannelTTL, broadcasterUserIDs, formatID, func(ctx context.Context, misses []int64) (map[int64]kickapitypes.ChannelData, string, error) {
+		channelsData, err := fetch(ctx, misses)
+		if channelsData == nil {
+			return nil, "", err
+		}
+
+		found := make(map[int64]kickapitypes.ChannelData, len(channelsData.Data))
+		for _, channel := range c.shareable(channelsData.Data) {
+			found[int64(channel.BroadcasterUserID)] = channel
+			c.cache.store.set(channelSlugPrefix+strings.ToLower(channel.Slug), channel, true, c.cache.config.Now().Add(c.cache.config.ChannelTTL))
+		}
+		return found, channelsData.Message, err
+	})
+
+	return channelsResponse(channels, message, err)
+}
+
+func (c *channelCache) getChannelsBySlug(ctx context.Context, slugs []string, fetch func(context.Context, []string) (*kickapitypes.Channels, error)) (*kickapitypes.Channels, error) {
+	channels, message, err := resolve(ctx, c.cache, channelSlugPrefix, c.cache.config.ChannelTTL, slugs, strings.ToLower, func(ctx context.Context, misses []string) (map[string]kickapitypes.ChannelData, string, error) {
+		channelsData, err := fetch(ctx, misses)
+		if channelsData == nil {
+			return nil, "", err
+		}
+
+		requested := make(map[string]string, len(misses))
+		for _, slug := range misses {
+			requested[strings.ToLower(slug)] = slug
+		}
+
+		found := make(map[string]kickapitypes.ChannelData, len(channelsData.Data))
+		for _, channel := range c.shareable(channelsData.Data) {
+			if slug, ok := requested[strings.ToLower(channel.Slug)]; ok {
+				found[slug] = channel
+			}
+			c.cache.store.set(channelPrefix+formatID(int64(channel.BroadcasterUserID)), channel, true, c.cache.config.Now().Add(c.cache.config.ChannelTTL))
+		}
+		return found, channelsData.Message, err
+	})
+
+	return channelsResponse(channels, message, err)
+}
+
+// shareable strips the stream key, which is only returned to the owner of the access token.
+func (c *channelCache) shareable(channels []kickapitypes.ChannelData) []kickapitypes.ChannelData {
+	stripped := make([]kickapitypes.ChannelData, len(channels))
+	for i, channel := range channels {
+		channel.Stream.Key = ""
+		stripped[i] = channel
+	}
+	return stripped
+}
+
+func channelsResponse(channels []kickapitypes.ChannelData, message string, err error) (*kickapitypes.Channels, error) {
+	if channels == nil {
+		return nil, err
+	}
+
+	return &kickapitypes.Channels{
+		Data:    channels,
+		Message: message,
+	}, err
+}
@@ -0,0 +1,28 @@
+// Package kickcache provides an opt-in read-through cache for the User, Channel and Category services.
+//
+// The cached services implement the same kickcontracts interfaces as the services returned by the
+// API client, so they can be dropped into existing code:
+//
+//	apiClient, err := kick.NewAPIClient(kickapitypes.APIClientConfig{
+//		HTTPClient: http.DefaultClient,
+//	})
+//	if err != nil {
+//		log.Fatalf("could not create APIClient: %v", err)
+//	}
+//
+//	cache := kickcache.New(kickcache.Config{
+//		UserTTL:    10 * time.Minute,
+//		MaxEntries: 5000,
+//	})
+//
+//	users := cache.User(apiClient.User())
+//	channels := cache.Channel(apiClient.Channel())
+//	categories := cache.Category(apiClient.Category())
+//
+// Webhook events can be fed into the cache to drop stale entries:
+//
+//	err = webhookClient.RegisterLivestreamMetadataUpdatedHandler(func(w http.ResponseWriter, r *http.Request, h kickwebhooktypes.KickWebhookHeaders, event kickwebhooktypes.LivestreamMetadataUpdated) {
+//		cache.OnLivestreamMetadataUpdated(event)
+//		w.WriteHeader(http.StatusOK)
+//	})
+package kickcache
@@ -0,0 +1,66 @@
+package kickcache
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// errPanicked is returned to the callers waiting on a call that panicked.
+var errPanicked = errors.New("kickcache: coalesced call panicked")
+
+type call struct {
+	done     chan struct{}
+	value    any
+	err      error
+	panicked any
+}
+
+// flightGroup coalesces concurrent calls with the same key into a single call.
+type flightGroup struct {
+	mu    sync.Mutex
+	calls map[string]*call
+}
+
+// do runs fn once for all concurrent callers of key.
+//
+// fn runs with the first caller's context stripped of its cancellation, so a caller that gives up
+// does not fail the others. Every caller stops waiting when its own context is done. A panic in fn is
+// raised again for the first caller when it is still waiting, the others get errPanicked.
+func (g *flightGroup) do(ctx context.Context, key string, fn func(ctx context.Context) (any, error)) (any, error) {
+	g.mu.Lock()
+	if g.calls == nil {
+		g.calls = make(map[string]*call)
+	}
+	current, inFlight := g.calls[key]
+	if !inFlight {
+		current = &call{done: make(chan struct{}), err: errPanicked}
+		g.calls[key] = current
+		go g.run(context.WithoutCancel(ctx), key, current, fn)
+	}
+	g.mu.Unlock()
+
+	select {
+	case <-current.done:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if !inFlight && current.panicked != nil {
+		panic(current.panicked)
+	}
+	return current.value, current.err
+}
+
+func (g *flightGroup) run(ctx context.Context, key string, current *call, fn func(ctx context.Context) (any, error)) {
+	defer func() {
+		current.panicked = recover()
+
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+		close(current.done)
+	}()
+
+	current.value, current.err = fn(ctx)
+}
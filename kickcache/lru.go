@@ -0,0 +1,110 @@
+package kickcache
+
+import (
+	"container/list"
+	"strings"
+	"sync"
+	"time"
+)
+
+type entry struct {
+	key       string
+	value     any
+	found     bool
+	expiresAt time.Time
+}
+
+// lru is a size bounded least recently used store with per entry expiry.
+type lru struct {
+	mu         sync.Mutex
+	maxEntries int
+	order      *list.List
+	items      map[string]*list.Element
+}
+
+func newLRU(maxEntries int) *lru {
+	return &lru{
+		maxEntries: maxEntries,
+		order:      list.New(),
+		items:      make(map[string]*list.Element),
+	}
+}
+
+// get returns a copy of the entry, so callers can read it after set replaced the value.
+func (l *lru) get(key string, now time.Time) (entry, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	element, ok := l.items[key]
+	if !ok {
+		return entry{}, false
+	}
+
+	cached := element.Value.(*entry)
+	if !now.Before(cached.expiresAt) {
+		l.removeElement(element)
+		return entry{}, false
+	}
+
+	l.order.MoveToFront(element)
+	return *cached, true
+}
+
+func (l *lru) set(key string, value any, found bool, expiresAt time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if element, ok := l.items[key]; ok {
+		element.Value = &entry{
+			key:       key,
+			value:     value,
+			found:     found,
+			expiresAt: expiresAt,
+		}
+		l.order.MoveToFront(element)
+		return
+	}
+
+	l.items[key] = l.order.PushFront(&entry{
+		key:       key,
+		value:     value,
+		found:     found,
+		expiresAt: expiresAt,
+	})
+
+	for l.order.Len() > l.maxEntries {
+		l.removeElement(l.order.Back())
+	}
+}
+
+func (l *lru) delete(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if element, ok := l.items[key]; ok {
+		l.removeElement(element)
+	}
+}
+
+func (l *lru) deletePrefix(prefix string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for key, element := range l.items {
+		if strings.HasPrefix(key, prefix) {
+			l.removeElement(element)
+		}
+	}
+}
+
+func (l *lru) len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.order.Len()
+}
+
+func (l *lru) removeElement(element *list.Element) {
+	l.order.Remove(element)
+	delete(l.items, element.Value.(*entry).key)
+}
@@ -0,0 +1,77 @@
+package kickcache
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickcontracts"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+)
+
+type userCache struct {
+	cache *Cache
+	next  kickcontracts.User
+}
+
+func (c *userCache) GetUsersByID(ctx context.Context, accessToken string, userIDs []int64) (*kickapitypes.Users, error) {
+	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
+		return nil, err
+	}
+	if len(userIDs) == 0 {
+		return c.next.GetUsersByID(ctx, accessToken, userIDs)
+	}
+	return c.getUsers(ctx, userIDs, func(ctx context.Context, misses []int64) (*kickapitypes.Users, error) {
+		return c.next.GetUsersByID(ctx, accessToken, misses)
+	})
+}
+
+func (c *userCache) GetAllUsersByID(ctx context.Context, accessToken string, userIDs []int64) (*kickapitypes.Users, error) {
+	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
+		return nil, err
+	}
+	if len(userIDs) == 0 {
+		return c.next.GetAllUsersByID(ctx, accessToken, userIDs)
+	}
+	return c.getUsers(ctx, userIDs, func(ctx context.Context, misses []int64) (*kickapitypes.Users, error) {
+		return c.next.GetAllUsersByID(ctx, accessToken, misses)
+	})
+}
+
+func (c *userCache) GetUserByID(ctx context.Context, accessToken string, userID int64) (*kickapitypes.Users, error) {
+	return c.GetUsersByID(ctx, accessToken, []int64{userID})
+}
+
+// GetCurrentUser is not cached as the result depends on the access token.
+func (c *userCache) GetCurrentUser(ctx context.Context, accessToken string) (*kickapitypes.Users, error) {
+	return c.next.GetCurrentUser(ctx, accessToken)
+}
+
+func (c *userCache) getUsers(ctx context.Context, userIDs []int64, fetch func(context.Context, []int64) (*kickapitypes.Users, error)) (*kickapitypes.Users, error) {
+	users, message, err := resolve(ctx, c.cache, userPrefix, c.cache.config.UserTTL, userIDs, formatID, func(ctx context.Context, misses []int64) (map[int64]kickapitypes.UserData, string, error) {
+		usersData, err := fetch(ctx, misses)
+		if usersData == nil {
+			return nil, "", err
+		}
+
+		found := make(map[int64]kickapitypes.UserData, len(usersData.Data))
+		for _, user := range usersData.Data {
+			// The email is only returned to the owner of the access token and must not be shared.
+			user.Email = ""
+			found[int64(user.UserID)] = user
+		}
+		return found, usersData.Message, err
+	})
+	if users == nil {
+		return nil, err
+	}
+
+	return &kickapitypes.Users{
+		Data:    users,
+		Message: message,
+	}, err
+}
+
+func formatID(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
@@ -0,0 +1,318 @@
+package kickcassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/henrikah/kick-go-sdk/v2/internal/httpclient"
+	"github.com/henrikah/kick-go-sdk/v2/internal/logging"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+)
+
+// Mode selects whether a Cassette records or replays interactions.
+type Mode int
+
+const (
+	// ModeReplay serves responses from the cassette file and never sends requests.
+	ModeReplay Mode = iota
+	// ModeRecord sends requests with the HTTP client and records them to the cassette file.
+	ModeRecord
+)
+
+// Config configures a Cassette.
+type Config struct {
+	// Path is the cassette file, it is read in ModeReplay and written by Save in ModeRecord.
+	Path string
+
+	// Mode selects recording or replaying. Defaults to ModeReplay.
+	Mode Mode
+
+	// HTTPClient sends the requests in ModeRecord. Defaults to http.DefaultClient when nil.
+	HTTPClient httpclient.ClientInterface
+}
+
+// Interaction is a recorded request and response pair.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest is a scrubbed request as stored in a cassette.
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// RecordedResponse is a scrubbed response as stored in a cassette.
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+type cassetteFile struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Cassette records or replays HTTP interactions. It is safe for concurrent use.
+type Cassette struct {
+	path         string
+	mode         Mode
+	httpClient   httpclient.ClientInterface
+	mu           sync.Mutex
+	interactions []Interaction
+	used         []bool
+}
+
+var _ httpclient.ClientInterface = (*Cassette)(nil)
+
+// New creates a Cassette. In ModeReplay the cassette file is loaded and must exist.
+func New(config Config) (*Cassette, error) {
+	if err := kickerrors.ValidateNotEmpty("Path", config.Path); err != nil {
+		return nil, err
+	}
+
+	cassette := &Cassette{
+		path:       config.Path,
+		mode:       config.Mode,
+		httpClient: config.HTTPClient,
+	}
+	if cassette.httpClient == nil {
+		cassette.httpClient = http.DefaultClient
+	}
+
+	if cassette.mode == ModeReplay {
+		content, err := os.ReadFile(config.Path)
+		if err != nil {
+			return nil, fmt.Errorf("could not read cassette: %w", err)
+		}
+
+		var file cassetteFile
+		if err := json.Unmarshal(content, &file); err != nil {
+			return nil, fmt.Errorf("could not decode cassette '%s': %w", config.Path, err)
+		}
+
+		cassette.interactions = file.Interactions
+		cassette.used = make([]bool, len(file.Interactions))
+	}
+
+	return cassette, nil
+}
+
+// Do records or replays the request depending on the mode of the cassette.
+func (c *Cassette) Do(req *http.Request) (*http.Response, error) {
+	body, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	recorded := scrubRequest(req, body)
+
+	if c.mode == ModeRecord {
+		return c.record(req, recorded)
+	}
+
+	return c.replay(req, recorded)
+}
+
+// Save writes the recorded interactions to the cassette file. It does nothing in ModeReplay.
+func (c *Cassette) Save() error {
+	if c.mode != ModeRecord {
+		return nil
+	}
+
+	c.mu.Lock()
+	content, err := json.MarshalIndent(cassetteFile{Interactions: c.interactions}, "", "  ")
+	c.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(c.path, append(content, '\n'), 0o644)
+}
+
+// Interactions returns the interactions recorded or loaded so far.
+func (c *Cassette) Interactions() []Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Interaction(nil), c.interactions...)
+}
+
+// Unused returns the replayable interactions that were never matched, so tests can assert every recorded call was made.
+func (c *Cassette) Unused() []Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var unused []Interaction
+	for i, interaction := range c.interactions {
+		if !c.used[i] {
+			unused = append(unused, interaction)
+		}
+	}
+	return unused
+}
+
+func (c *Cassette) record(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	responseBody, err := io.ReadAll(resp.Body)
+	closeErr := resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	if closeErr != nil {
+		return nil, closeErr
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	c.mu.Lock()
+	c.interactions = append(c.interactions, Interaction{
+		Request: recorded,
+		Response: RecordedResponse{
+			StatusCode: resp.StatusCode,
+			Header:     scrubHeader(resp.Header),
+			Body:       logging.RedactBody(responseBody),
+		},
+	})
+	c.used = append(c.used, true)
+	c.mu.Unlock()
+
+	return resp, nil
+}
+
+func (c *Cassette) replay(req *http.Request, recorded RecordedRequest) (*http.Response, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, interaction := range c.interactions {
+		if c.used[i] || !matches(interaction.Request, recorded) {
+			continue
+		}
+
+		c.used[i] = true
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+			StatusCode:    interaction.Response.StatusCode,
+			Header:        interaction.Response.Header.Clone(),
+			Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+			ContentLength: int64(len(interaction.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, kickerrors.SetUnmatchedRequestError(c.path, recorded.Method, recorded.URL, recorded.Body)
+}
+
+// readRequestBody reads the body of req and replaces it so it can still be sent.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err := req.Body.Close(); err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+// scrubRequest returns the request as stored in a cassette, with secrets removed from the URL, headers and body.
+func scrubRequest(req *http.Request, body []byte) RecordedRequest {
+	return RecordedRequest{
+		Method: req.Method,
+		URL:    logging.RedactURL(req.URL),
+		Header: scrubHeader(req.Header),
+		Body:   scrubRequestBody(req.Header.Get("Content-Type"), body),
+	}
+}
+
+func scrubRequestBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		values, err := url.ParseQuery(string(body))
+		if err == nil {
+			return logging.RedactValues(values).Encode()
+		}
+	}
+
+	return logging.RedactBody(body)
+}
+
+func scrubHeader(header http.Header) http.Header {
+	if len(header) == 0 {
+		return nil
+	}
+
+	scrubbed := make(http.Header, len(header))
+	for name, values := range header {
+		if logging.IsSensitiveHeader(name) {
+			values = []string{logging.Redacted}
+		}
+		scrubbed[name] = append([]string(nil), values...)
+	}
+	return scrubbed
+}
+
+// matches reports whether a replayed request has the same method, path, query and body as a recorded one.
+func matches(recorded RecordedRequest, replayed RecordedRequest) bool {
+	if recorded.Method != replayed.Method {
+		return false
+	}
+
+	recordedURL, err := url.Parse(recorded.URL)
+	if err != nil {
+		return false
+	}
+	replayedURL, err := url.Parse(replayed.URL)
+	if err != nil {
+		return false
+	}
+
+	if recordedURL.Host != replayedURL.Host || recordedURL.Path != replayedURL.Path {
+		return false
+	}
+	if recordedURL.Query().Encode() != replayedURL.Query().Encode() {
+		return false
+	}
+
+	return normalizeBody(recorded.Body) == normalizeBody(replayed.Body)
+}
+
+// normalizeBody makes JSON bodies comparable regardless of key order and whitespace.
+func normalizeBody(body string) string {
+	var decoded any
+	if err := json.Unmarshal([]byte(body), &decoded); err != nil {
+		return body
+	}
+
+	normalized, err := json.Marshal(decoded)
+	if err != nil {
+		return body
+	}
+	return string(normalized)
+}
@@ -0,0 +1,24 @@
+// Package kickcassette records Kick API interactions to cassette files and replays them without network access.
+//
+// A Cassette implements the HTTPClient of APIClientConfig and OAuthClientConfig. Record against the real API once:
+//
+//	cassette, err := kickcassette.New(kickcassette.Config{
+//		Path: "testdata/send_chat_message.json",
+//		Mode: kickcassette.ModeRecord,
+//	})
+//	if err != nil {
+//		log.Fatalf("could not create cassette: %v", err)
+//	}
+//	defer cassette.Save()
+//
+//	apiClient, err := kick.NewAPIClient(kickapitypes.APIClientConfig{
+//		HTTPClient: cassette,
+//	})
+//
+// Then replay it in tests by switching to ModeReplay. Access tokens, refresh tokens, client secrets, authorization
+// codes and email addresses are scrubbed before anything is written, so cassettes can be committed. The same scrubbing
+// is applied to replayed requests before they are matched, so tests can use any token.
+//
+// Replayed requests are matched by method, path, query and body. Every recorded interaction is used once, in the order
+// it was recorded, and a request that matches no unused interaction fails with an UnmatchedRequestError.
+package kickcassette
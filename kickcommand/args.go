@@ -0,0 +1,59 @@
+package kickcommand
+
+import (
+	"strings"
+	"unicode"
+)
+
+// SplitArgs splits the text into arguments on whitespace. Text wrapped in double quotes is kept as one argument
+// without the quotes, and an unterminated quote runs until the end of the text.
+func SplitArgs(text string) []string {
+	var (
+		args    []string
+		current strings.Builder
+		quoted  bool
+		started bool
+	)
+
+	for _, r := range text {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			started = true
+		case unicode.IsSpace(r) && !quoted:
+			if started {
+				args = append(args, current.String())
+				current.Reset()
+				started = false
+			}
+		default:
+			current.WriteRune(r)
+			started = true
+		}
+	}
+	if started {
+		args = append(args, current.String())
+	}
+
+	return args
+}
+
+// parse splits content into the lowercased command name and the raw argument text after it.
+// ok is false when content does not start with prefix followed by a name.
+func parse(prefix, content string) (name string, rawArgs string, ok bool) {
+	content = strings.TrimSpace(content)
+	if !strings.HasPrefix(content, prefix) {
+		return "", "", false
+	}
+	content = content[len(prefix):]
+
+	name = content
+	if i := strings.IndexFunc(content, unicode.IsSpace); i >= 0 {
+		name, rawArgs = content[:i], content[i:]
+	}
+	if name == "" {
+		return "", "", false
+	}
+
+	return strings.ToLower(name), strings.TrimSpace(rawArgs), true
+}
@@ -0,0 +1,35 @@
+// Package kickcommand routes chat commands from ChatMessageSent webhooks to handlers.
+//
+// Commands are registered on a Router with aliases, a minimum permission based on the badges of the sender and
+// optional per-user and global cooldowns. Replies are sent with Chat.SendChatMessageAsBot as a reply to the
+// message that invoked the command, and a help command listing the available commands is built in:
+//
+//	router, err := kickcommand.NewRouter(kickcommand.RouterConfig{
+//		Chat: apiClient.Chat(),
+//		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+//			return botAccessToken, nil
+//		},
+//	})
+//	if err != nil {
+//		log.Fatalf("could not create router: %v", err)
+//	}
+//
+//	err = router.Register(kickcommand.Command{
+//		Name:         "so",
+//		Aliases:      []string{"shoutout"},
+//		Description:  "Shouts out a channel",
+//		Usage:        "<channel>",
+//		Permission:   kickcommand.PermissionModerator,
+//		MinArgs:      1,
+//		UserCooldown: 30 * time.Second,
+//		Handler: func(ctx context.Context, invocation *kickcommand.Invocation) error {
+//			_, err := invocation.Reply(ctx, "Go follow https://kick.com/"+invocation.Args[0])
+//			return err
+//		},
+//	})
+//	if err != nil {
+//		log.Fatalf("could not register command: %v", err)
+//	}
+//
+//	webhookClient.RegisterChatMessageSentHandler(router.WebhookHandler())
+package kickcommand
@@ -0,0 +1,67 @@
+package kickcommand
+
+import (
+	"github.com/henrikah/kick-go-sdk/v2/kickwebhooktypes"
+)
+
+// Permission is the minimum role a chatter needs to run a command. Every role includes the roles below it,
+// so a moderator can run subscriber commands.
+type Permission int
+
+const (
+	// PermissionEveryone allows every chatter to run the command.
+	PermissionEveryone Permission = iota
+	// PermissionSubscriber requires a subscriber, VIP, moderator or broadcaster badge.
+	PermissionSubscriber
+	// PermissionVIP requires a VIP, moderator or broadcaster badge.
+	PermissionVIP
+	// PermissionModerator requires a moderator or broadcaster badge.
+	PermissionModerator
+	// PermissionBroadcaster only allows the broadcaster.
+	PermissionBroadcaster
+)
+
+// String returns the name of the permission as shown in help output.
+func (p Permission) String() string {
+	switch p {
+	case PermissionSubscriber:
+		return "subscriber"
+	case PermissionVIP:
+		return "vip"
+	case PermissionModerator:
+		return "moderator"
+	case PermissionBroadcaster:
+		return "broadcaster"
+	default:
+		return "everyone"
+	}
+}
+
+// badgePermissions maps the badge types Kick sends in Identity.Badges to the permission they grant.
+var badgePermissions = map[string]Permission{
+	"subscriber":  PermissionSubscriber,
+	"founder":     PermissionSubscriber,
+	"og":          PermissionSubscriber,
+	"vip":         PermissionVIP,
+	"moderator":   PermissionModerator,
+	"broadcaster": PermissionBroadcaster,
+}
+
+// PermissionOf returns the highest permission the sender of the message holds, based on the badges of the sender.
+// The sender is always the broadcaster when its user ID matches the broadcaster of the channel.
+func PermissionOf(message kickwebhooktypes.ChatMessageSent) Permission {
+	if message.Sender.UserID != 0 && message.Sender.UserID == message.Broadcaster.UserID {
+		return PermissionBroadcaster
+	}
+
+	permission := PermissionEveryone
+	if message.Sender.Identity == nil {
+		return permission
+	}
+	for _, badge := range message.Sender.Identity.Badges {
+		if badgePermission, ok := badgePermissions[badge.Type]; ok && badgePermission > permission {
+			permission = badgePermission
+		}
+	}
+	return permission
+}
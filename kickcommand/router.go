@@ -0,0 +1,395 @@
+package kickcommand
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/henrikah/kick-go-sdk/v2/internal/logging"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickcontracts"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/kickwebhooktypes"
+)
+
+const (
+	defaultPrefix             = "!"
+	defaultHelpCommand        = "help"
+	defaultHelpUserCooldown   = 30 * time.Second
+	defaultHelpGlobalCooldown = 5 * time.Second
+)
+
+// Handler runs a command. An error returned by the handler is returned by Router.Handle.
+type Handler func(ctx context.Context, invocation *Invocation) error
+
+// Command is a chat command registered on a Router.
+type Command struct {
+	// Name is the name the command is invoked with, without the prefix. Names are case-insensitive.
+	Name string
+
+	// Aliases are alternative names the command can be invoked with.
+	Aliases []string
+
+	// Description is shown in the help output.
+	Description string
+
+	// Usage describes the arguments in the help output, e.g. "<username> [reason]".
+	Usage string
+
+	// Permission is the minimum role needed to run the command. Defaults to PermissionEveryone.
+	Permission Permission
+
+	// MinArgs is the minimum number of arguments. Invocations with fewer arguments are answered with the usage,
+	// which starts the cooldowns like a run of the command.
+	MinArgs int
+
+	// UserCooldown is the time a chatter has to wait before running the command again in the same channel.
+	UserCooldown time.Duration
+
+	// GlobalCooldown is the time every chatter has to wait after the command ran in a channel.
+	GlobalCooldown time.Duration
+
+	// Handler runs the command.
+	Handler Handler
+}
+
+// Invocation is a single run of a command from a chat message.
+type Invocation struct {
+	// Command is the command being run.
+	Command *Command
+
+	// Name is the lowercased name or alias the command was invoked with.
+	Name string
+
+	// Args are the arguments after the command name, see SplitArgs.
+	Args []string
+
+	// RawArgs is the text after the command name with surrounding whitespace removed.
+	RawArgs string
+
+	// Permission is the highest permission of the sender.
+	Permission Permission
+
+	// Message is the chat message the command was invoked from.
+	Message kickwebhooktypes.ChatMessageSent
+
+	router *Router
+}
+
+// Reply sends message as the bot in reply to the message the command was invoked from.
+func (i *Invocation) Reply(ctx context.Context, message string) (*kickapitypes.SendChatResponse, error) {
+	return i.router.reply(ctx, i.Message, message)
+}
+
+// RouterConfig configures a Router.
+type RouterConfig struct {
+	// Chat is the service used to send replies.
+	Chat kickcontracts.Chat
+
+	// AccessToken returns the bot access token used to reply in the channel of the broadcaster.
+	AccessToken func(ctx context.Context, broadcasterUserID int) (string, error)
+
+	// Prefix is the text every command starts with. Defaults to "!" when empty.
+	Prefix string
+
+	// HelpCommand is the name of the built-in help command. Defaults to "help" when empty.
+	HelpCommand string
+
+	// DisableHelp disables the built-in help command.
+	DisableHelp bool
+
+	// HelpUserCooldown is the time a chatter has to wait before the help command answers them again in the same
+	// channel. Defaults to 30 seconds when zero; a negative value disables it.
+	HelpUserCooldown time.Duration
+
+	// HelpGlobalCooldown is the time every chatter has to wait after the help command answered in a channel.
+	// Defaults to 5 seconds when zero; a negative value disables it.
+	HelpGlobalCooldown time.Duration
+
+	// Logger receives denied and cooled down invocations at debug level. Defaults to slog.Default() when nil.
+	Logger *slog.Logger
+}
+
+type cooldownKey struct {
+	command           *Command
+	broadcasterUserID int
+	userID            int
+}
+
+// Router dispatches chat messages to registered commands. It is safe for concurrent use.
+type Router struct {
+	config    RouterConfig
+	logger    *slog.Logger
+	help      *Command
+	mu        sync.Mutex
+	commands  []*Command
+	names     map[string]*Command
+	cooldowns map[cooldownKey]time.Time
+}
+
+// NewRouter creates a new Router with the provided configuration.
+func NewRouter(config RouterConfig) (*Router, error) {
+	if err := kickerrors.ValidateNotNil("Chat", config.Chat); err != nil {
+		return nil, err
+	}
+	if err := kickerrors.ValidateNotNil("AccessToken", config.AccessToken); err != nil {
+		return nil, err
+	}
+
+	config.Prefix = cmp.Or(config.Prefix, defaultPrefix)
+	config.HelpCommand = strings.ToLower(cmp.Or(config.HelpCommand, defaultHelpCommand))
+	config.HelpUserCooldown = cmp.Or(config.HelpUserCooldown, defaultHelpUserCooldown)
+	config.HelpGlobalCooldown = cmp.Or(config.HelpGlobalCooldown, defaultHelpGlobalCooldown)
+
+	return &Router{
+		config: config,
+		logger: logging.OrDefault(config.Logger),
+		help: &Command{
+			Name:           config.HelpCommand,
+			UserCooldown:   config.HelpUserCooldown,
+			GlobalCooldown: config.HelpGlobalCooldown,
+		},
+		names:     make(map[string]*Command),
+		cooldowns: make(map[cooldownKey]time.Time),
+	}, nil
+}
+
+// Register adds a command to the router. Registering a name or alias that is already taken returns a
+// *kickerrors.ValidationError and leaves the router unchanged.
+func (r *Router) Register(command Command) error {
+	if err := kickerrors.ValidateNotEmpty("Name", command.Name); err != nil {
+		return err
+	}
+	if err := kickerrors.ValidateNotNil("Handler", command.Handler); err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(command.Aliases)+1)
+	for i, name := range append([]string{command.Name}, command.Aliases...) {
+		name = strings.ToLower(name)
+		if name == "" || strings.ContainsFunc(name, unicode.IsSpace) {
+			field := "Aliases"
+			if i == 0 {
+				field = "Name"
+			}
+			return &kickerrors.ValidationError{Field: field, Message: "cannot be empty or contain whitespace"}
+		}
+		names = append(names, name)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, name := range names {
+		if _, exists := r.names[name]; exists || (!r.config.DisableHelp && name == r.config.HelpCommand) {
+			return &kickerrors.ValidationError{Field: "Name", Message: fmt.Sprintf("command %q is already registered", name)}
+		}
+	}
+
+	registered := &command
+	registered.Name = names[0]
+	registered.Aliases = names[1:]
+	r.commands = append(r.commands, registered)
+	for _, name := range names {
+		r.names[name] = registered
+	}
+
+	return nil
+}
+
+// Handle runs the command in the message, if any. Messages that are not commands, commands the sender is not
+// allowed to run and commands on cooldown are ignored, including their usage and help replies. The error of the
+// command handler is returned.
+func (r *Router) Handle(ctx context.Context, message kickwebhooktypes.ChatMessageSent) error {
+	name, rawArgs, ok := parse(r.config.Prefix, message.Content)
+	if !ok {
+		return nil
+	}
+
+	permission := PermissionOf(message)
+
+	if !r.config.DisableHelp && name == r.config.HelpCommand {
+		if !r.startCooldown(r.help, message, time.Now()) {
+			r.logger.DebugContext(ctx, "kick: command on cooldown",
+				slog.String("command", r.help.Name),
+				slog.Int("broadcaster_user_id", message.Broadcaster.UserID),
+				slog.Int("user_id", message.Sender.UserID),
+			)
+			return nil
+		}
+		_, err := r.reply(ctx, message, r.helpReply(strings.ToLower(rawArgs), permission))
+		return err
+	}
+
+	r.mu.Lock()
+	command, found := r.names[name]
+	r.mu.Unlock()
+	if !found {
+		return nil
+	}
+
+	logAttrs := []any{
+		slog.String("command", command.Name),
+		slog.Int("broadcaster_user_id", message.Broadcaster.UserID),
+		slog.Int("user_id", message.Sender.UserID),
+	}
+
+	if permission < command.Permission {
+		r.logger.DebugContext(ctx, "kick: command denied", append(logAttrs, slog.String("permission", command.Permission.String()))...)
+		return nil
+	}
+
+	invocation := &Invocation{
+		Command:    command,
+		Name:       name,
+		Args:       SplitArgs(rawArgs),
+		RawArgs:    rawArgs,
+		Permission: permission,
+		Message:    message,
+		router:     r,
+	}
+
+	if !r.startCooldown(command, message, time.Now()) {
+		r.logger.DebugContext(ctx, "kick: command on cooldown", logAttrs...)
+		return nil
+	}
+
+	if len(invocation.Args) < command.MinArgs {
+		_, err := r.reply(ctx, message, "Usage: "+r.usage(command))
+		return err
+	}
+
+	return command.Handler(ctx, invocation)
+}
+
+// WebhookHandler returns a handler for RegisterChatMessageSentHandler that runs the command in every message.
+// Kick always receives status 200 so failed commands are not redelivered; errors are logged instead.
+func (r *Router) WebhookHandler() func(http.ResponseWriter, *http.Request, kickwebhooktypes.KickWebhookHeaders, kickwebhooktypes.ChatMessageSent) {
+	return func(writer http.ResponseWriter, request *http.Request, kickHeaders kickwebhooktypes.KickWebhookHeaders, message kickwebhooktypes.ChatMessageSent) {
+		if err := r.Handle(request.Context(), message); err != nil {
+			r.logger.ErrorContext(request.Context(), "kick: command failed",
+				slog.String("message_id", message.MessageID),
+				slog.Int("broadcaster_user_id", message.Broadcaster.UserID),
+				slog.Any("error", err),
+			)
+		}
+		writer.WriteHeader(http.StatusOK)
+	}
+}
+
+// Help returns a single line listing the commands available to chatters with the permission.
+func (r *Router) Help(permission Permission) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	names := make([]string, 0, len(r.commands)+1)
+	for _, command := range r.commands {
+		if permission >= command.Permission {
+			names = append(names, r.config.Prefix+command.Name)
+		}
+	}
+	if !r.config.DisableHelp {
+		names = append(names, r.config.Prefix+r.config.HelpCommand)
+	}
+
+	return "Commands: " + strings.Join(names, ", ")
+}
+
+// CommandHelp returns the usage, description, aliases and permission of the command with the name or alias.
+func (r *Router) CommandHelp(name string) (string, bool) {
+	r.mu.Lock()
+	command, found := r.names[strings.ToLower(strings.TrimPrefix(name, r.config.Prefix))]
+	r.mu.Unlock()
+	if !found {
+		return "", false
+	}
+
+	help := r.usage(command)
+	if command.Description != "" {
+		help += " - " + command.Description
+	}
+
+	var details []string
+	if len(command.Aliases) > 0 {
+		aliases := make([]string, len(command.Aliases))
+		for i, alias := range command.Aliases {
+			aliases[i] = r.config.Prefix + alias
+		}
+		details = append(details, "aliases: "+strings.Join(aliases, ", "))
+	}
+	if command.Permission != PermissionEveryone {
+		details = append(details, command.Permission.String()+" only")
+	}
+	if len(details) > 0 {
+		help += " (" + strings.Join(details, "; ") + ")"
+	}
+
+	return help, true
+}
+
+func (r *Router) helpReply(name string, permission Permission) string {
+	if name == "" {
+		return r.Help(permission)
+	}
+	if help, found := r.CommandHelp(name); found {
+		return help
+	}
+	return fmt.Sprintf("Unknown command %s%s", r.config.Prefix, strings.TrimPrefix(name, r.config.Prefix))
+}
+
+func (r *Router) usage(command *Command) string {
+	if command.Usage == "" {
+		return r.config.Prefix + command.Name
+	}
+	return r.config.Prefix + command.Name + " " + command.Usage
+}
+
+// startCooldown reports whether the command is off cooldown for the sender and, when it is, starts its cooldowns.
+// The cooldowns start before the handler runs or any reply is sent so concurrent deliveries cannot answer twice.
+func (r *Router) startCooldown(command *Command, message kickwebhooktypes.ChatMessageSent, now time.Time) bool {
+	if command.UserCooldown <= 0 && command.GlobalCooldown <= 0 {
+		return true
+	}
+
+	globalKey := cooldownKey{command: command, broadcasterUserID: message.Broadcaster.UserID}
+	userKey := globalKey
+	userKey.userID = message.Sender.UserID
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if now.Before(r.cooldowns[globalKey]) || now.Before(r.cooldowns[userKey]) {
+		return false
+	}
+
+	for key, until := range r.cooldowns {
+		if !now.Before(until) {
+			delete(r.cooldowns, key)
+		}
+	}
+
+	if command.GlobalCooldown > 0 {
+		r.cooldowns[globalKey] = now.Add(command.GlobalCooldown)
+	}
+	if command.UserCooldown > 0 {
+		r.cooldowns[userKey] = now.Add(command.UserCooldown)
+	}
+
+	return true
+}
+
+func (r *Router) reply(ctx context.Context, message kickwebhooktypes.ChatMessageSent, content string) (*kickapitypes.SendChatResponse, error) {
+	accessToken, err := r.config.AccessToken(ctx, message.Broadcaster.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	replyToMessageID := message.MessageID
+	return r.config.Chat.SendChatMessageAsBot(ctx, accessToken, &replyToMessageID, content)
+}
@@ -37,4 +37,48 @@ type Category interface {
 	//		}
 	//	}
 	SearchCategories(ctx context.Context, accessToken string, filters kickfilters.CategoriesFilter) (*kickapitypes.GetCategoriesResponse, error)
+
+	// GetCategoryByID retrieves the details of a single category.
+	//
+	// Example:
+	//
+	//	client, err := kick.NewAPIClient(kickapitypes.APIClientConfig{
+	//	    HTTPClient: http.DefaultClient,
+	//	})
+	//	if err != nil {
+	//	    log.Fatal(err)
+	//	}
+	//
+	//	category, err := client.Category().GetCategoryByID(context.TODO(), accessToken, 42)
+	//	if err != nil {
+	//		if apiErr := kickerrors.IsAPIError(err); apiErr != nil {
+	//			log.Printf("API error: %d %s", apiErr.StatusCode, apiErr.Message)
+	//		} else {
+	//			log.Printf("internal error: %v", err)
+	//		}
+	//	}
+	GetCategoryByID(ctx context.Context, accessToken string, categoryID int) (*kickapitypes.GetCategoryResponse, error)
+
+	// GetCategoriesByID retrieves the details of multiple categories.
+	//
+	// Categories are requested with bounded concurrency and returned in input order. Categories that
+	// do not exist are left out of the result. If any other request fails the successfully retrieved
+	// categories are returned together with a *kickerrors.BatchError.
+	//
+	// Example:
+	//
+	//	client, err := kick.NewAPIClient(kickapitypes.APIClientConfig{
+	//	    HTTPClient: http.DefaultClient,
+	//	})
+	//	if err != nil {
+	//	    log.Fatal(err)
+	//	}
+	//
+	//	categories, err := client.Category().GetCategoriesByID(context.TODO(), accessToken, []int{42, 43})
+	//	if batchErr := kickerrors.IsBatchError(err); batchErr != nil {
+	//		log.Printf("%d categories could not be retrieved", len(batchErr.Failed))
+	//	} else if err != nil {
+	//		log.Printf("internal error: %v", err)
+	//	}
+	GetCategoriesByID(ctx context.Context, accessToken string, categoryIDs []int) (*kickapitypes.GetCategoriesByIDResponse, error)
 }
@@ -180,4 +180,56 @@ type ChannelReward interface {
 	//		}
 	//	}
 	RejectRewardRedemption(ctx context.Context, accessToken string, redemptionIDs []string) (*kickapitypes.RedemptionDecision, error)
+
+	// AcceptAllRewardRedemptions accepts any number of reward redemptions.
+	//
+	// The IDs are sent to AcceptRewardRedemption in chunks of 25, the most it accepts per call, with up to
+	// kickapitypes.APIClientConfig.BatchConcurrency chunks in flight. Redemptions keep the order of the chunks.
+	// If a chunk fails the results of the other chunks are returned with a *kickerrors.BatchError.
+	// The redemptions of a failed chunk stay pending.
+	//
+	// Example:
+	//
+	//	client, err := kick.NewAPIClient(kickapitypes.APIClientConfig{
+	//	    HTTPClient: http.DefaultClient,
+	//	})
+	//	if err != nil {
+	//	    log.Fatal(err)
+	//	}
+	//
+	//	decision, err := client.ChannelReward().AcceptAllRewardRedemptions(context.TODO(), accessToken, redemptionIDs)
+	//	if batchErr := kickerrors.IsBatchError(err); batchErr != nil {
+	//		for _, failed := range batchErr.Failed {
+	//			log.Printf("chunk %d failed: %v", failed.Index, failed.Err)
+	//		}
+	//	} else if err != nil {
+	//		log.Printf("internal error: %v", err)
+	//	}
+	AcceptAllRewardRedemptions(ctx context.Context, accessToken string, redemptionIDs []string) (*kickapitypes.RedemptionDecision, error)
+
+	// RejectAllRewardRedemptions rejects any number of reward redemptions.
+	//
+	// The IDs are sent to RejectRewardRedemption in chunks of 25, the most it accepts per call, with up to
+	// kickapitypes.APIClientConfig.BatchConcurrency chunks in flight. Redemptions keep the order of the chunks.
+	// If a chunk fails the results of the other chunks are returned with a *kickerrors.BatchError.
+	// The redemptions of a failed chunk stay pending.
+	//
+	// Example:
+	//
+	//	client, err := kick.NewAPIClient(kickapitypes.APIClientConfig{
+	//	    HTTPClient: http.DefaultClient,
+	//	})
+	//	if err != nil {
+	//	    log.Fatal(err)
+	//	}
+	//
+	//	decision, err := client.ChannelReward().RejectAllRewardRedemptions(context.TODO(), accessToken, redemptionIDs)
+	//	if batchErr := kickerrors.IsBatchError(err); batchErr != nil {
+	//		for _, failed := range batchErr.Failed {
+	//			log.Printf("chunk %d failed: %v", failed.Index, failed.Err)
+	//		}
+	//	} else if err != nil {
+	//		log.Printf("internal error: %v", err)
+	//	}
+	RejectAllRewardRedemptions(ctx context.Context, accessToken string, redemptionIDs []string) (*kickapitypes.RedemptionDecision, error)
 }
@@ -95,6 +95,58 @@ type Channel interface {
 	//	}
 	GetChannelsByBroadcasterSlug(ctx context.Context, accessToken string, slugs []string) (*kickapitypes.Channels, error)
 
+	// GetAllChannelsByBroadcasterUserID retrieves channels for any number of broadcaster user IDs.
+	//
+	// The IDs are sent to GetChannelsByBroadcasterUserID in chunks of 50, the most it accepts per call, with up to
+	// kickapitypes.APIClientConfig.BatchConcurrency chunks in flight. Channels keep the order of the chunks.
+	// If a chunk fails the results of the other chunks are returned with a *kickerrors.BatchError.
+	//
+	// Example:
+	//
+	//	client, err := kick.NewAPIClient(kickapitypes.APIClientConfig{
+	//	    HTTPClient: http.DefaultClient,
+	//	})
+	//	if err != nil {
+	//	    log.Fatal(err)
+	//	}
+	//
+	//	channels, err := client.Channel().GetAllChannelsByBroadcasterUserID(context.TODO(), accessToken, broadcasterUserIDs)
+	//	if batchErr := kickerrors.IsBatchError(err); batchErr != nil {
+	//		for _, failed := range batchErr.Failed {
+	//			log.Printf("chunk %d failed: %v", failed.Index, failed.Err)
+	//		}
+	//	} else if err != nil {
+	//		log.Printf("internal error: %v", err)
+	//	}
+	GetAllChannelsByBroadcasterUserID(ctx context.Context, accessToken string, broadcasterUserIDs []int64) (*kickapitypes.Channels, error)
+
+	// GetAllChannelsByBroadcasterSlug retrieves channels for any number of slugs.
+	//
+	// Every slug must be at most 25 characters.
+	//
+	// The slugs are sent to GetChannelsByBroadcasterSlug in chunks of 50, the most it accepts per call, with up to
+	// kickapitypes.APIClientConfig.BatchConcurrency chunks in flight. Channels keep the order of the chunks.
+	// If a chunk fails the results of the other chunks are returned with a *kickerrors.BatchError.
+	//
+	// Example:
+	//
+	//	client, err := kick.NewAPIClient(kickapitypes.APIClientConfig{
+	//	    HTTPClient: http.DefaultClient,
+	//	})
+	//	if err != nil {
+	//	    log.Fatal(err)
+	//	}
+	//
+	//	channels, err := client.Channel().GetAllChannelsByBroadcasterSlug(context.TODO(), accessToken, slugs)
+	//	if batchErr := kickerrors.IsBatchError(err); batchErr != nil {
+	//		for _, failed := range batchErr.Failed {
+	//			log.Printf("chunk %d failed: %v", failed.Index, failed.Err)
+	//		}
+	//	} else if err != nil {
+	//		log.Printf("internal error: %v", err)
+	//	}
+	GetAllChannelsByBroadcasterSlug(ctx context.Context, accessToken string, slugs []string) (*kickapitypes.Channels, error)
+
 	// UpdateChannel updates a broadcaster's channel with the provided data.
 	//
 	// Example:
@@ -35,6 +35,9 @@ type EventsSubscription interface {
 
 	// CreateEventSubscriptions creates new event subscriptions for the authenticated user.
 	//
+	// When an event fails to subscribe, the response is returned together with a *kickerrors.PartialSubscriptionError
+	// listing the failed events, unless StrictEventSubscriptions is set on the client config.
+	//
 	// Example:
 	//
 	//	client, err := kick.NewAPIClient(kickapitypes.APIClientConfig{
@@ -59,6 +62,9 @@ type EventsSubscription interface {
 	//
 	// broadcasterUserID specifies which broadcaster the subscriptions are for.
 	//
+	// When an event fails to subscribe, the response is returned together with a *kickerrors.PartialSubscriptionError
+	// listing the failed events, unless StrictEventSubscriptions is set on the client config.
+	//
 	// Example:
 	//
 	//
@@ -79,6 +85,34 @@ type EventsSubscription interface {
 	//	}
 	CreateEventSubscriptionsAsApp(ctx context.Context, accessToken string, broadcasterUserID int, events []kickwebhookenum.WebhookType) (*kickapitypes.CreateEventSubscriptionsResponse, error)
 
+	// CreateVersionedEventSubscriptions creates new event subscriptions with an explicit version per event.
+	//
+	// broadcasterUserID is optional for user access tokens and required for app access tokens.
+	//
+	// When an event fails to subscribe, the response is returned together with a *kickerrors.PartialSubscriptionError
+	// listing the failed events, unless StrictEventSubscriptions is set on the client config.
+	//
+	// Example:
+	//
+	//	client, err := kick.NewAPIClient(kickapitypes.APIClientConfig{
+	//	    HTTPClient: http.DefaultClient,
+	//	})
+	//	if err != nil {
+	//	    log.Fatal(err)
+	//	}
+	//
+	//	broadcasterUserID := 12345
+	//	events := []kickapitypes.EventObject{{Name: string(kickwebhookenum.ChatMessageSent), Version: 1}}
+	//	createEventSubscriptionsResponse, err := client.EventsSubscription().CreateVersionedEventSubscriptions(context.TODO(), accessToken, &broadcasterUserID, events)
+	//	if err != nil {
+	//		if apiErr := kickerrors.IsAPIError(err); apiErr != nil {
+	//			log.Printf("API error: %d %s", apiErr.StatusCode, apiErr.Message)
+	//		} else {
+	//			log.Printf("internal error: %v", err)
+	//		}
+	//	}
+	CreateVersionedEventSubscriptions(ctx context.Context, accessToken string, broadcasterUserID *int, events []kickapitypes.EventObject) (*kickapitypes.CreateEventSubscriptionsResponse, error)
+
 	// DeleteEventSubscriptions deletes event subscriptions by their IDs.
 	//
 	// Example:
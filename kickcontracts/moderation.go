@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickmoderation"
 )
 
 // Moderation handles user moderation actions such as timeouts and bans.
@@ -13,6 +14,9 @@ import (
 type Moderation interface {
 	// TimeOutUser temporarily restricts a user's ability to chat.
 	//
+	// durationInMinutes is in minutes, between 1 and 10080 (7 days). Prefer Moderate with kickmoderation.NewTimeout,
+	// which takes a time.Duration.
+	//
 	// reason is optional.
 	//
 	// Example:
@@ -24,7 +28,7 @@ type Moderation interface {
 	//	    log.Fatal(err)
 	//	}
 	//
-	//	moderationResponse, err := client.Moderation().TimeOutUser(context.TODO(), accessToken, broadcasterID, userID, 600, nil)
+	//	moderationResponse, err := client.Moderation().TimeOutUser(context.TODO(), accessToken, broadcasterID, userID, 10, nil)
 	//	if err != nil {
 	//		if apiErr := kickerrors.IsAPIError(err); apiErr != nil {
 	//			log.Printf("API error: %d %s", apiErr.StatusCode, apiErr.Message)
@@ -32,7 +36,7 @@ type Moderation interface {
 	//			log.Printf("internal error: %v", err)
 	//		}
 	//	}
-	TimeOutUser(ctx context.Context, accessToken string, broadcasterUserID, userID, durationInSeconds int, reason *string) (*kickapitypes.ModerationResponse, error)
+	TimeOutUser(ctx context.Context, accessToken string, broadcasterUserID, userID, durationInMinutes int, reason *string) (*kickapitypes.ModerationResponse, error)
 
 	// BanUser permanently bans a user from a broadcaster's channel.
 	//
@@ -57,6 +61,29 @@ type Moderation interface {
 	//	}
 	BanUser(ctx context.Context, accessToken string, broadcasterUserID, userID int, reason *string) (*kickapitypes.ModerationResponse, error)
 
+	// Moderate bans or times out a user with a request built by kickmoderation.NewBan or kickmoderation.NewTimeout.
+	//
+	// Example:
+	//
+	//	client, err := kick.NewAPIClient(kickapitypes.APIClientConfig{
+	//	    HTTPClient: http.DefaultClient,
+	//	})
+	//	if err != nil {
+	//	    log.Fatal(err)
+	//	}
+	//
+	//	request := kickmoderation.NewTimeout(broadcasterID, userID, 10*time.Minute).WithReason("spam")
+	//
+	//	moderationResponse, err := client.Moderation().Moderate(context.TODO(), accessToken, request)
+	//	if err != nil {
+	//		if apiErr := kickerrors.IsAPIError(err); apiErr != nil {
+	//			log.Printf("API error: %d %s", apiErr.StatusCode, apiErr.Message)
+	//		} else {
+	//			log.Printf("internal error: %v", err)
+	//		}
+	//	}
+	Moderate(ctx context.Context, accessToken string, request kickmoderation.Request) (*kickapitypes.ModerationResponse, error)
+
 	// UnbanUser removes a ban from a user.
 	//
 	// Example:
@@ -33,6 +33,31 @@ type User interface {
 	//	}
 	GetUsersByID(ctx context.Context, accessToken string, userIDs []int64) (*kickapitypes.Users, error)
 
+	// GetAllUsersByID retrieves any number of users by their IDs.
+	//
+	// The IDs are sent to GetUsersByID in chunks of 50, the most it accepts per call, with up to
+	// kickapitypes.APIClientConfig.BatchConcurrency chunks in flight. Users keep the order of the chunks.
+	// If a chunk fails the results of the other chunks are returned with a *kickerrors.BatchError.
+	//
+	// Example:
+	//
+	//	client, err := kick.NewAPIClient(kickapitypes.APIClientConfig{
+	//	    HTTPClient: http.DefaultClient,
+	//	})
+	//	if err != nil {
+	//	    log.Fatal(err)
+	//	}
+	//
+	//	users, err := client.User().GetAllUsersByID(context.TODO(), accessToken, userIDs)
+	//	if batchErr := kickerrors.IsBatchError(err); batchErr != nil {
+	//		for _, failed := range batchErr.Failed {
+	//			log.Printf("chunk %d failed: %v", failed.Index, failed.Err)
+	//		}
+	//	} else if err != nil {
+	//		log.Printf("internal error: %v", err)
+	//	}
+	GetAllUsersByID(ctx context.Context, accessToken string, userIDs []int64) (*kickapitypes.Users, error)
+
 	// GetUserByID retrieves a single user by ID.
 	//
 	// Example:
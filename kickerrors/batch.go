@@ -0,0 +1,58 @@
+package kickerrors
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type ChunkError struct {
+	Index  int
+	Offset int
+	Size   int
+	Err    error
+}
+
+func (e ChunkError) Error() string {
+	return fmt.Sprintf("chunk %d (items %d-%d): %s", e.Index, e.Offset, e.Offset+e.Size-1, e.Err)
+}
+
+func (e ChunkError) Unwrap() error {
+	return e.Err
+}
+
+type BatchError struct {
+	TotalChunks int
+	Failed      []ChunkError
+}
+
+func (e *BatchError) Error() string {
+	messages := make([]string, len(e.Failed))
+	for i, failed := range e.Failed {
+		messages[i] = failed.Error()
+	}
+	return fmt.Sprintf("%d of %d chunks failed: %s", len(e.Failed), e.TotalChunks, strings.Join(messages, "; "))
+}
+
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, len(e.Failed))
+	for i, failed := range e.Failed {
+		errs[i] = failed.Err
+	}
+	return errs
+}
+
+func SetBatchError(totalChunks int, failed []ChunkError) *BatchError {
+	return &BatchError{
+		TotalChunks: totalChunks,
+		Failed:      failed,
+	}
+}
+
+func IsBatchError(err error) *BatchError {
+	var batchErr *BatchError
+	if errors.As(err, &batchErr) {
+		return batchErr
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package kickerrors
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// TargetError is a single moderation action of a bulk operation that failed.
+type TargetError struct {
+	Action            string
+	BroadcasterUserID int
+	UserID            int
+	Err               error
+}
+
+func (e TargetError) Error() string {
+	return fmt.Sprintf("%s user %d in channel %d: %s", e.Action, e.UserID, e.BroadcasterUserID, e.Err)
+}
+
+func (e TargetError) Unwrap() error {
+	return e.Err
+}
+
+// BulkModerationError is returned when some of the actions of a bulk moderation operation failed.
+type BulkModerationError struct {
+	TotalTargets int
+	Failed       []TargetError
+}
+
+func (e *BulkModerationError) Error() string {
+	messages := make([]string, len(e.Failed))
+	for i, failed := range e.Failed {
+		messages[i] = failed.Error()
+	}
+	return fmt.Sprintf("%d of %d moderation actions failed: %s", len(e.Failed), e.TotalTargets, strings.Join(messages, "; "))
+}
+
+func (e *BulkModerationError) Unwrap() []error {
+	errs := make([]error, len(e.Failed))
+	for i, failed := range e.Failed {
+		errs[i] = failed.Err
+	}
+	return errs
+}
+
+func SetBulkModerationError(totalTargets int, failed []TargetError) *BulkModerationError {
+	return &BulkModerationError{
+		TotalTargets: totalTargets,
+		Failed:       failed,
+	}
+}
+
+func IsBulkModerationError(err error) *BulkModerationError {
+	var bulkErr *BulkModerationError
+	if errors.As(err, &bulkErr) {
+		return bulkErr
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package kickerrors
+
+import (
+	"errors"
+	"fmt"
+)
+
+// UnmatchedRequestError is returned when a replayed request matches none of the recorded interactions.
+type UnmatchedRequestError struct {
+	Cassette string
+	Method   string
+	URL      string
+	Body     string
+}
+
+func (e *UnmatchedRequestError) Error() string {
+	return fmt.Sprintf("cassette '%s' has no unused interaction matching %s %s with body '%s'", e.Cassette, e.Method, e.URL, e.Body)
+}
+
+func SetUnmatchedRequestError(cassette string, method string, url string, body string) *UnmatchedRequestError {
+	return &UnmatchedRequestError{
+		Cassette: cassette,
+		Method:   method,
+		URL:      url,
+		Body:     body,
+	}
+}
+
+func IsUnmatchedRequestError(err error) *UnmatchedRequestError {
+	var unmatchedRequestError *UnmatchedRequestError
+	if errors.As(err, &unmatchedRequestError) {
+		return unmatchedRequestError
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package kickerrors
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// SubscriptionChangeError is a single create or delete of an event subscription that failed.
+type SubscriptionChangeError struct {
+	Action            string
+	BroadcasterUserID int
+	Event             string
+	Version           int
+	Reason            string
+	Err               error
+}
+
+func (e SubscriptionChangeError) Error() string {
+	return fmt.Sprintf("%s %s v%d for broadcaster %d: %s", e.Action, e.Event, e.Version, e.BroadcasterUserID, e.Reason)
+}
+
+func (e SubscriptionChangeError) Unwrap() error {
+	return e.Err
+}
+
+// ReconcileError is returned when some of the changes of a reconciliation failed.
+type ReconcileError struct {
+	TotalChanges int
+	Failed       []SubscriptionChangeError
+}
+
+func (e *ReconcileError) Error() string {
+	messages := make([]string, len(e.Failed))
+	for i, failed := range e.Failed {
+		messages[i] = failed.Error()
+	}
+	return fmt.Sprintf("%d of %d subscription changes failed: %s", len(e.Failed), e.TotalChanges, strings.Join(messages, "; "))
+}
+
+func (e *ReconcileError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Failed))
+	for _, failed := range e.Failed {
+		if failed.Err != nil {
+			errs = append(errs, failed.Err)
+		}
+	}
+	return errs
+}
+
+func SetReconcileError(totalChanges int, failed []SubscriptionChangeError) *ReconcileError {
+	return &ReconcileError{
+		TotalChanges: totalChanges,
+		Failed:       failed,
+	}
+}
+
+func IsReconcileError(err error) *ReconcileError {
+	var reconcileErr *ReconcileError
+	if errors.As(err, &reconcileErr) {
+		return reconcileErr
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package kickerrors
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// SubscribedEvent is an event that was subscribed to successfully.
+type SubscribedEvent struct {
+	Event          string
+	Version        int
+	SubscriptionID string
+}
+
+// SubscriptionFailure is an event that could not be subscribed to and the reason Kick gave.
+type SubscriptionFailure struct {
+	Event   string
+	Version int
+	Reason  string
+}
+
+// PartialSubscriptionError is returned when one or more events of a create event subscriptions request failed.
+type PartialSubscriptionError struct {
+	Subscribed []SubscribedEvent
+	Failed     []SubscriptionFailure
+}
+
+func (e *PartialSubscriptionError) Error() string {
+	messages := make([]string, len(e.Failed))
+	for i, failed := range e.Failed {
+		messages[i] = fmt.Sprintf("%s v%d: %s", failed.Event, failed.Version, failed.Reason)
+	}
+	return fmt.Sprintf("%d of %d event subscriptions failed: %s", len(e.Failed), len(e.Failed)+len(e.Subscribed), strings.Join(messages, "; "))
+}
+
+// SubscriptionIDs returns the IDs of the subscriptions that were created.
+func (e *PartialSubscriptionError) SubscriptionIDs() []string {
+	subscriptionIDs := make([]string, len(e.Subscribed))
+	for i, subscribed := range e.Subscribed {
+		subscriptionIDs[i] = subscribed.SubscriptionID
+	}
+	return subscriptionIDs
+}
+
+func SetPartialSubscriptionError(subscribed []SubscribedEvent, failed []SubscriptionFailure) *PartialSubscriptionError {
+	return &PartialSubscriptionError{
+		Subscribed: subscribed,
+		Failed:     failed,
+	}
+}
+
+func IsPartialSubscriptionError(err error) *PartialSubscriptionError {
+	var partialSubscriptionErr *PartialSubscriptionError
+	if errors.As(err, &partialSubscriptionErr) {
+		return partialSubscriptionErr
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+// Package kickescalation escalates the moderation response to repeated offenses of a user.
+//
+// A Policy keeps the infractions of every (broadcaster, user) pair in a Store and answers each reported offense
+// with the next step of the ladder: by default a warning in chat, a 5 minute timeout, a 1 hour timeout and then a
+// ban. Infractions stop counting after DecayAfter. Bans and timeouts by human moderators are counted as well when
+// the ModerationBanned webhook is passed to the policy:
+//
+//	policy, err := kickescalation.NewPolicy(kickescalation.PolicyConfig{
+//		Chat:       apiClient.Chat(),
+//		Moderation: apiClient.Moderation(),
+//		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+//			return moderatorAccessToken, nil
+//		},
+//		DecayAfter: 12 * time.Hour,
+//	})
+//	if err != nil {
+//		log.Fatalf("could not create policy: %v", err)
+//	}
+//
+//	webhookClient.RegisterModerationBannedHandler(policy.WebhookHandler())
+//
+//	outcome, err := policy.Report(ctx, kickescalation.Offense{
+//		BroadcasterUserID: message.Broadcaster.UserID,
+//		UserID:            message.Sender.UserID,
+//		Username:          message.Sender.Username,
+//		MessageID:         message.MessageID,
+//		Reason:            "spam",
+//	})
+//	if err != nil {
+//		log.Printf("could not escalate: %v", err)
+//	}
+package kickescalation
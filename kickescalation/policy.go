@@ -0,0 +1,340 @@
+package kickescalation
+
+import (
+	"cmp"
+	"context"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/henrikah/kick-go-sdk/v2/internal/logging"
+	"github.com/henrikah/kick-go-sdk/v2/kickcontracts"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/kickmoderation"
+	"github.com/henrikah/kick-go-sdk/v2/kickwebhooktypes"
+)
+
+const (
+	defaultDecayAfter = 24 * time.Hour
+	echoWindow        = time.Minute
+)
+
+// StepType is the action of a Step.
+type StepType string
+
+const (
+	// StepWarn sends a warning to chat as a reply to the offending message.
+	StepWarn StepType = "warn"
+	// StepTimeout times the user out for the Duration of the step.
+	StepTimeout StepType = "timeout"
+	// StepBan bans the user.
+	StepBan StepType = "ban"
+)
+
+// Step is a rung of the escalation ladder.
+type Step struct {
+	Type StepType
+
+	// Duration of a timeout. Kick takes timeouts in whole minutes, so the duration is rounded up to a minute.
+	Duration time.Duration
+}
+
+// DefaultSteps warns, times out for 5 minutes, times out for 1 hour and then bans.
+var DefaultSteps = []Step{
+	{Type: StepWarn},
+	{Type: StepTimeout, Duration: 5 * time.Minute},
+	{Type: StepTimeout, Duration: time.Hour},
+	{Type: StepBan},
+}
+
+// Infraction is a recorded offense of a user.
+type Infraction struct {
+	// At is when the offense happened.
+	At time.Time `json:"at"`
+
+	// Reason of the offense.
+	Reason string `json:"reason,omitempty"`
+
+	// Step is the action that was taken for the offense.
+	Step Step `json:"step"`
+
+	// Manual is true for infractions ingested from ModerationBanned webhooks.
+	Manual bool `json:"manual,omitempty"`
+}
+
+// Offense is a violation reported to the policy.
+type Offense struct {
+	BroadcasterUserID int
+	UserID            int
+
+	// Username is used in the warning message.
+	Username string
+
+	// MessageID is the offending message. The warning is sent as a reply to it when set.
+	MessageID string
+
+	// Reason is sent with timeouts and bans and shown in the warning.
+	Reason string
+}
+
+// Outcome is the result of reporting an offense.
+type Outcome struct {
+	// Step is the action taken for the offense.
+	Step Step
+
+	// Level is the 1-based rung of the ladder the offense reached.
+	Level int
+
+	// Infractions is the number of infractions within the decay time, including the offense.
+	Infractions int
+}
+
+// PolicyConfig configures a Policy.
+type PolicyConfig struct {
+	// Chat is the service used to send warnings.
+	Chat kickcontracts.Chat
+
+	// Moderation is the service used to time out and ban users.
+	Moderation kickcontracts.Moderation
+
+	// AccessToken returns the access token used to moderate the channel of the broadcaster.
+	AccessToken func(ctx context.Context, broadcasterUserID int) (string, error)
+
+	// Steps is the escalation ladder, repeated offenses beyond the last step repeat the last step.
+	// Defaults to DefaultSteps when nil.
+	Steps []Step
+
+	// DecayAfter is how long an infraction counts towards the history. Defaults to 24 hours when zero.
+	DecayAfter time.Duration
+
+	// Store persists the infraction history. Defaults to a MemoryStore.
+	Store Store
+
+	// Warning returns the warning sent to chat. Defaults to "@username, warning: reason" when nil.
+	Warning func(offense Offense) string
+
+	// Logger receives every escalation. Defaults to slog.Default() when nil.
+	Logger *slog.Logger
+}
+
+// Policy escalates the response to repeated offenses of a user in a channel. It is safe for concurrent use.
+type Policy struct {
+	config PolicyConfig
+	logger *slog.Logger
+	mu     sync.Mutex
+}
+
+// NewPolicy creates a new Policy with the provided configuration.
+func NewPolicy(config PolicyConfig) (*Policy, error) {
+	if err := kickerrors.ValidateNotNil("AccessToken", config.AccessToken); err != nil {
+		return nil, err
+	}
+	if config.Steps == nil {
+		config.Steps = DefaultSteps
+	}
+	if err := kickerrors.ValidateMinItems("Steps", config.Steps, 1); err != nil {
+		return nil, err
+	}
+	for _, step := range config.Steps {
+		switch step.Type {
+		case StepWarn:
+			if err := kickerrors.ValidateNotNil("Chat", config.Chat); err != nil {
+				return nil, err
+			}
+		case StepTimeout:
+			if err := kickmoderation.ValidateTimeout("Steps.Duration", step.Duration); err != nil {
+				return nil, err
+			}
+			fallthrough
+		case StepBan:
+			if err := kickerrors.ValidateNotNil("Moderation", config.Moderation); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, &kickerrors.ValidationError{Field: "Steps.Type", Message: "must be warn, timeout or ban"}
+		}
+	}
+
+	config.DecayAfter = cmp.Or(config.DecayAfter, defaultDecayAfter)
+	if config.Store == nil {
+		config.Store = NewMemoryStore()
+	}
+	if config.Warning == nil {
+		config.Warning = defaultWarning
+	}
+
+	return &Policy{
+		config: config,
+		logger: logging.OrDefault(config.Logger),
+	}, nil
+}
+
+// Report records the offense and takes the action of the next step of the ladder. The infraction is stored before
+// the action is taken, so a failed action still counts towards the history.
+func (p *Policy) Report(ctx context.Context, offense Offense) (*Outcome, error) {
+	if err := kickerrors.ValidateBroadcasterUserID(offense.BroadcasterUserID); err != nil {
+		return nil, err
+	}
+	if err := kickerrors.ValidateUserID(offense.UserID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	p.mu.Lock()
+	infractions, err := p.active(ctx, offense.BroadcasterUserID, offense.UserID, now)
+	if err != nil {
+		p.mu.Unlock()
+		return nil, err
+	}
+
+	level := min(len(infractions), len(p.config.Steps)-1)
+	step := p.config.Steps[level]
+	infractions = append(infractions, Infraction{At: now, Reason: offense.Reason, Step: step})
+
+	err = p.config.Store.Save(ctx, offense.BroadcasterUserID, offense.UserID, infractions)
+	p.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	outcome := &Outcome{Step: step, Level: level + 1, Infractions: len(infractions)}
+
+	p.logger.InfoContext(ctx, "kick: moderation escalated",
+		slog.Int("broadcaster_user_id", offense.BroadcasterUserID),
+		slog.Int("user_id", offense.UserID),
+		slog.String("step", string(step.Type)),
+		slog.Int("level", outcome.Level),
+		slog.Int("infractions", outcome.Infractions),
+	)
+
+	return outcome, p.act(ctx, offense, step)
+}
+
+// History returns the infractions of the user in the channel that have not decayed, oldest first.
+func (p *Policy) History(ctx context.Context, broadcasterUserID, userID int) ([]Infraction, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.active(ctx, broadcasterUserID, userID, time.Now())
+}
+
+// RecordBan counts a ban or timeout from a ModerationBanned webhook towards the history of the banned user, so
+// actions by human moderators escalate the next offense. The webhook Kick sends for an action the policy took
+// itself is recognized within a minute of the action and not counted twice.
+func (p *Policy) RecordBan(ctx context.Context, event kickwebhooktypes.ModerationBanned) error {
+	broadcasterUserID, userID := event.Broadcaster.UserID, event.BannedUser.UserID
+	if err := kickerrors.ValidateBroadcasterUserID(broadcasterUserID); err != nil {
+		return err
+	}
+	if err := kickerrors.ValidateUserID(userID); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	at := event.Metadata.CreatedAt.Time()
+	if at.IsZero() {
+		at = now
+	}
+
+	step := Step{Type: StepBan}
+	if expiresAt := event.Metadata.ExpiresAt.Time(); !expiresAt.IsZero() {
+		step = Step{Type: StepTimeout, Duration: expiresAt.Sub(at)}
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	infractions, err := p.active(ctx, broadcasterUserID, userID, now)
+	if err != nil {
+		return err
+	}
+
+	for _, infraction := range infractions {
+		if !infraction.Manual && infraction.Step.Type == step.Type && absDuration(infraction.At.Sub(at)) < echoWindow {
+			return nil
+		}
+	}
+
+	infractions = append(infractions, Infraction{At: at, Reason: event.Metadata.Reason, Step: step, Manual: true})
+	return p.config.Store.Save(ctx, broadcasterUserID, userID, infractions)
+}
+
+// WebhookHandler returns a handler for RegisterModerationBannedHandler that records every ban and timeout.
+func (p *Policy) WebhookHandler() func(http.ResponseWriter, *http.Request, kickwebhooktypes.KickWebhookHeaders, kickwebhooktypes.ModerationBanned) {
+	return func(writer http.ResponseWriter, request *http.Request, kickHeaders kickwebhooktypes.KickWebhookHeaders, event kickwebhooktypes.ModerationBanned) {
+		if err := p.RecordBan(request.Context(), event); err != nil {
+			p.logger.ErrorContext(request.Context(), "kick: recording ban failed",
+				slog.String("message_id", kickHeaders.MessageID),
+				slog.Any("error", err),
+			)
+			writer.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		writer.WriteHeader(http.StatusOK)
+	}
+}
+
+// active loads the history and drops the infractions that decayed. The caller must hold p.mu.
+func (p *Policy) active(ctx context.Context, broadcasterUserID, userID int, now time.Time) ([]Infraction, error) {
+	infractions, err := p.config.Store.Load(ctx, broadcasterUserID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	active := infractions[:0]
+	for _, infraction := range infractions {
+		if now.Sub(infraction.At) < p.config.DecayAfter {
+			active = append(active, infraction)
+		}
+	}
+	return active, nil
+}
+
+func (p *Policy) act(ctx context.Context, offense Offense, step Step) error {
+	accessToken, err := p.config.AccessToken(ctx, offense.BroadcasterUserID)
+	if err != nil {
+		return err
+	}
+
+	var reason *string
+	if offense.Reason != "" {
+		reason = &offense.Reason
+	}
+
+	switch step.Type {
+	case StepWarn:
+		var replyToMessageID *string
+		if offense.MessageID != "" {
+			replyToMessageID = &offense.MessageID
+		}
+		_, err = p.config.Chat.SendChatMessageAsBot(ctx, accessToken, replyToMessageID, p.config.Warning(offense))
+	case StepTimeout:
+		request := kickmoderation.NewTimeout(offense.BroadcasterUserID, offense.UserID, step.Duration)
+		if reason != nil {
+			request.WithReason(*reason)
+		}
+		_, err = p.config.Moderation.Moderate(ctx, accessToken, request)
+	case StepBan:
+		_, err = p.config.Moderation.BanUser(ctx, accessToken, offense.BroadcasterUserID, offense.UserID, reason)
+	}
+	return err
+}
+
+func defaultWarning(offense Offense) string {
+	warning := "Warning"
+	if offense.Username != "" {
+		warning = "@" + offense.Username + ", warning"
+	}
+	if offense.Reason != "" {
+		warning += ": " + offense.Reason
+	}
+	return warning
+}
+
+func absDuration(duration time.Duration) time.Duration {
+	if duration < 0 {
+		return -duration
+	}
+	return duration
+}
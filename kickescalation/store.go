@@ -0,0 +1,55 @@
+package kickescalation
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// Store persists the infraction history of every (broadcaster, user) pair. Implementations must be safe for
+// concurrent use.
+type Store interface {
+	// Load returns the infractions of the user in the channel of the broadcaster, oldest first.
+	Load(ctx context.Context, broadcasterUserID, userID int) ([]Infraction, error)
+
+	// Save replaces the infractions of the user in the channel of the broadcaster. Saving no infractions removes
+	// the user.
+	Save(ctx context.Context, broadcasterUserID, userID int, infractions []Infraction) error
+}
+
+type historyKey struct {
+	broadcasterUserID int
+	userID            int
+}
+
+// MemoryStore is a Store that keeps the infractions in memory.
+type MemoryStore struct {
+	mu          sync.RWMutex
+	infractions map[historyKey][]Infraction
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		infractions: make(map[historyKey][]Infraction),
+	}
+}
+
+func (s *MemoryStore) Load(_ context.Context, broadcasterUserID, userID int) ([]Infraction, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return slices.Clone(s.infractions[historyKey{broadcasterUserID, userID}]), nil
+}
+
+func (s *MemoryStore) Save(_ context.Context, broadcasterUserID, userID int, infractions []Infraction) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(infractions) == 0 {
+		delete(s.infractions, historyKey{broadcasterUserID, userID})
+		return nil
+	}
+	s.infractions[historyKey{broadcasterUserID, userID}] = slices.Clone(infractions)
+	return nil
+}
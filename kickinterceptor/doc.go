@@ -0,0 +1,27 @@
+// Package kickinterceptor defines the interceptors that wrap every request made by the API and OAuth clients.
+//
+// Interceptors are set through Interceptors on APIClientConfig and OAuthClientConfig and run in the order
+// they are given, the first interceptor is the outermost. Each interceptor receives the logical operation
+// that made the request, e.g. "Chat.SendChatMessageAsBot", and decides whether and how to call the next one:
+//
+//	requestID := func(operation string, req *http.Request, next kickinterceptor.Invoker) (*http.Response, error) {
+//		req.Header.Set("X-Request-Id", uuid.NewString())
+//		return next(req)
+//	}
+//
+//	rateLimit := func(operation string, req *http.Request, next kickinterceptor.Invoker) (*http.Response, error) {
+//		resp, err := next(req)
+//		if err == nil {
+//			log.Printf("%s: %s requests remaining", operation, resp.Header.Get("X-RateLimit-Remaining"))
+//		}
+//		return resp, err
+//	}
+//
+//	apiClient, err := kick.NewAPIClient(kickapitypes.APIClientConfig{
+//		HTTPClient:   http.DefaultClient,
+//		Interceptors: []kickinterceptor.Interceptor{requestID, rateLimit},
+//	})
+//	if err != nil {
+//		log.Fatalf("could not create APIClient: %v", err)
+//	}
+package kickinterceptor
@@ -0,0 +1,14 @@
+package kickinterceptor
+
+import "net/http"
+
+// Invoker sends the request to the next interceptor, or to the HTTP client after the last interceptor.
+type Invoker func(req *http.Request) (*http.Response, error)
+
+// Interceptor wraps a single request made by a service method.
+//
+// operation is the service method that made the request, e.g. "Chat.SendChatMessageAsBot".
+// An interceptor may change req before calling next, inspect or replace the response returned by next,
+// or return a response or error without calling next at all. A returned response is handled exactly like
+// a response from the HTTP client, so its body is read and closed by the SDK.
+type Interceptor func(operation string, req *http.Request, next Invoker) (*http.Response, error)
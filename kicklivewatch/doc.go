@@ -0,0 +1,29 @@
+// Package kicklivewatch detects livestreams going live, going offline and changing title or category by polling,
+// as a fallback for deployments that cannot receive webhooks.
+//
+// A Watcher searches the livestreams of its broadcasters in batches and compares them with the previous poll. The
+// changes are emitted as the LivestreamStatusUpdated and LivestreamMetadataUpdated events the webhook client
+// delivers, so the same code can handle both. Polls speed up after a change and slow down while nothing happens:
+//
+//	watcher, err := kicklivewatch.NewWatcher(kicklivewatch.WatcherConfig{
+//		Livestream: apiClient.Livestream(),
+//		AccessToken: func(ctx context.Context) (string, error) {
+//			return appAccessToken, nil
+//		},
+//		Broadcasters: []int{12345, 67890},
+//		OnStatus: func(ctx context.Context, event kickwebhooktypes.LivestreamStatusUpdated) {
+//			if event.IsLive {
+//				log.Printf("%s went live: %s", event.Broadcaster.ChannelSlug, event.Title)
+//			}
+//		},
+//	})
+//	if err != nil {
+//		log.Fatalf("could not create watcher: %v", err)
+//	}
+//
+//	go watcher.Run(ctx)
+//
+// Events emitted by a Watcher carry the broadcaster's user ID, channel slug and profile picture, the livestreams
+// endpoint does not return the username. A stream that went offline is reported with the time it was detected
+// as EndedAt.
+package kicklivewatch
@@ -0,0 +1,292 @@
+package kicklivewatch
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"log/slog"
+	"math/rand/v2"
+	"sync"
+	"time"
+
+	"github.com/henrikah/kick-go-sdk/v2/internal/batch"
+	"github.com/henrikah/kick-go-sdk/v2/internal/logging"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickcontracts"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/kickfilters"
+	"github.com/henrikah/kick-go-sdk/v2/kicktime"
+	"github.com/henrikah/kick-go-sdk/v2/kickwebhooktypes"
+)
+
+const (
+	defaultBatchSize   = 50
+	maxBatchSize       = 100
+	defaultMinInterval = 15 * time.Second
+	defaultMaxInterval = 2 * time.Minute
+	defaultJitter      = 0.1
+
+	// backoff is how much the interval grows after a poll without changes.
+	backoff = 1.5
+)
+
+// WatcherConfig configures a Watcher.
+type WatcherConfig struct {
+	// Livestream is the service used to search the livestreams.
+	Livestream kickcontracts.Livestream
+
+	// AccessToken returns the access token used to search the livestreams, e.g. an app access token.
+	AccessToken func(ctx context.Context) (string, error)
+
+	// Broadcasters are the channels to watch.
+	Broadcasters []int
+
+	// BatchSize is how many broadcasters are searched per request, at most 100. Defaults to 50 when zero.
+	BatchSize int
+
+	// MinInterval is the time between polls after a change was detected. Defaults to 15 seconds when zero.
+	MinInterval time.Duration
+
+	// MaxInterval is the longest time between polls, which the interval grows to while nothing changes and
+	// polls fail. Defaults to 2 minutes when zero.
+	MaxInterval time.Duration
+
+	// Jitter randomizes every interval by up to this fraction in either direction, so many watchers do not poll
+	// in lockstep. Must be less than 1. Defaults to 0.1 when zero, negative disables it.
+	Jitter float64
+
+	// EmitInitial emits a status event for the streams that are live at the first poll of their broadcaster. By
+	// default the first poll only records the state, so a restart does not announce streams that went live before
+	// it again. A broadcaster whose batch failed is first polled when its batch succeeds.
+	EmitInitial bool
+
+	// OnStatus receives a status event when a stream goes live or offline.
+	OnStatus func(ctx context.Context, event kickwebhooktypes.LivestreamStatusUpdated)
+
+	// OnMetadata receives a metadata event when the title or category of a live stream changes.
+	OnMetadata func(ctx context.Context, event kickwebhooktypes.LivestreamMetadataUpdated)
+
+	// Logger receives failed polls. Defaults to slog.Default() when nil.
+	Logger *slog.Logger
+}
+
+// Watcher detects livestream changes by polling, as a fallback for deployments that cannot receive webhooks.
+// It is safe for concurrent use.
+type Watcher struct {
+	config WatcherConfig
+	logger *slog.Logger
+
+	mu          sync.Mutex
+	polled      map[int]bool
+	livestreams map[int]kickapitypes.LivestreamResponseData
+}
+
+// NewWatcher creates a new Watcher with the provided configuration.
+func NewWatcher(config WatcherConfig) (*Watcher, error) {
+	if err := kickerrors.ValidateNotNil("Livestream", config.Livestream); err != nil {
+		return nil, err
+	}
+	if err := kickerrors.ValidateNotNil("AccessToken", config.AccessToken); err != nil {
+		return nil, err
+	}
+	if err := kickerrors.ValidateMinItems("Broadcasters", config.Broadcasters, 1); err != nil {
+		return nil, err
+	}
+	for _, broadcasterUserID := range config.Broadcasters {
+		if err := kickerrors.ValidateBroadcasterUserID(broadcasterUserID); err != nil {
+			return nil, err
+		}
+	}
+
+	config.BatchSize = cmp.Or(config.BatchSize, defaultBatchSize)
+	if err := kickerrors.ValidateBetween("BatchSize", config.BatchSize, 1, maxBatchSize); err != nil {
+		return nil, err
+	}
+	config.MinInterval = cmp.Or(config.MinInterval, defaultMinInterval)
+	config.MaxInterval = cmp.Or(config.MaxInterval, defaultMaxInterval)
+	if config.MaxInterval < config.MinInterval {
+		return nil, &kickerrors.ValidationError{Field: "MaxInterval", Message: "cannot be less than MinInterval"}
+	}
+	config.Jitter = cmp.Or(config.Jitter, defaultJitter)
+	if config.Jitter >= 1 {
+		return nil, &kickerrors.ValidationError{Field: "Jitter", Message: "must be less than 1"}
+	}
+
+	return &Watcher{
+		config:      config,
+		logger:      logging.OrDefault(config.Logger),
+		polled:      map[int]bool{},
+		livestreams: map[int]kickapitypes.LivestreamResponseData{},
+	}, nil
+}
+
+// Live returns the livestream of the broadcaster as of the last poll, and whether it is live.
+func (w *Watcher) Live(broadcasterUserID int) (kickapitypes.LivestreamResponseData, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	livestream, ok := w.livestreams[broadcasterUserID]
+	return livestream, ok
+}
+
+// Poll searches the livestreams of every broadcaster once and emits the events of the changes since the last poll.
+// It reports whether anything changed. Broadcasters of a failed batch keep their state until the next poll.
+// The searches run without holding the state, so Live does not wait for them. The callbacks run after the state is
+// updated, so they can call Live.
+func (w *Watcher) Poll(ctx context.Context) (bool, error) {
+	accessToken, err := w.config.AccessToken(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	var events []any
+	var errs []error
+
+	for _, chunk := range batch.Chunk(w.config.Broadcasters, w.config.BatchSize) {
+		ids := make([]int64, len(chunk))
+		for i, broadcasterUserID := range chunk {
+			ids[i] = int64(broadcasterUserID)
+		}
+
+		filters := kickfilters.NewLivestreamsFilter().WithBroadcasterUserIDs(ids).WithLimit(maxBatchSize)
+		response, err := w.config.Livestream.SearchLivestreams(ctx, accessToken, filters)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		live := make(map[int]kickapitypes.LivestreamResponseData, len(response.Data))
+		for _, livestream := range response.Data {
+			live[livestream.BroadcasterUserID] = livestream
+		}
+
+		w.mu.Lock()
+		for _, broadcasterUserID := range chunk {
+			current, isLive := live[broadcasterUserID]
+			events = append(events, w.update(broadcasterUserID, current, isLive)...)
+		}
+		w.mu.Unlock()
+	}
+
+	w.emit(ctx, events)
+	return len(events) > 0, errors.Join(errs...)
+}
+
+// update records the livestream of the broadcaster and returns the events of the change. The caller must hold w.mu.
+func (w *Watcher) update(broadcasterUserID int, current kickapitypes.LivestreamResponseData, isLive bool) []any {
+	previous, wasLive := w.livestreams[broadcasterUserID]
+	initial := !w.polled[broadcasterUserID]
+	w.polled[broadcasterUserID] = true
+
+	switch {
+	case isLive && !wasLive:
+		w.livestreams[broadcasterUserID] = current
+		if initial && !w.config.EmitInitial {
+			return nil
+		}
+		return []any{statusEvent(current, true, kicktime.KickTime{})}
+	case !isLive && wasLive:
+		delete(w.livestreams, broadcasterUserID)
+		return []any{statusEvent(previous, false, kicktime.New(time.Now()))}
+	case isLive && wasLive:
+		w.livestreams[broadcasterUserID] = current
+		if !previous.StartedAt.Time().IsZero() && !current.StartedAt.Time().Equal(previous.StartedAt.Time()) {
+			// The stream restarted between two polls.
+			return []any{
+				statusEvent(previous, false, current.StartedAt),
+				statusEvent(current, true, kicktime.KickTime{}),
+			}
+		}
+		if current.StreamTitle == previous.StreamTitle && current.Category.ID == previous.Category.ID {
+			return nil
+		}
+		return []any{metadataEvent(current)}
+	default:
+		return nil
+	}
+}
+
+// emit passes the events to the callbacks. The caller must not hold w.mu.
+func (w *Watcher) emit(ctx context.Context, events []any) {
+	for _, event := range events {
+		switch event := event.(type) {
+		case kickwebhooktypes.LivestreamStatusUpdated:
+			if w.config.OnStatus != nil {
+				w.config.OnStatus(ctx, event)
+			}
+		case kickwebhooktypes.LivestreamMetadataUpdated:
+			if w.config.OnMetadata != nil {
+				w.config.OnMetadata(ctx, event)
+			}
+		}
+	}
+}
+
+func statusEvent(livestream kickapitypes.LivestreamResponseData, isLive bool, endedAt kicktime.KickTime) kickwebhooktypes.LivestreamStatusUpdated {
+	return kickwebhooktypes.LivestreamStatusUpdated{
+		Broadcaster: broadcaster(livestream),
+		IsLive:      isLive,
+		Title:       livestream.StreamTitle,
+		StartedAt:   livestream.StartedAt,
+		EndedAt:     endedAt,
+	}
+}
+
+func metadataEvent(livestream kickapitypes.LivestreamResponseData) kickwebhooktypes.LivestreamMetadataUpdated {
+	return kickwebhooktypes.LivestreamMetadataUpdated{
+		Broadcaster: broadcaster(livestream),
+		Metadata: kickwebhooktypes.LivestreamMetadata{
+			Title:            livestream.StreamTitle,
+			Language:         livestream.Language,
+			HasMatureContent: livestream.HasMatureContent,
+			Category: kickwebhooktypes.Category{
+				ID:        livestream.Category.ID,
+				Name:      livestream.Category.Name,
+				Thumbnail: livestream.Category.Thumbnail,
+			},
+		},
+	}
+}
+
+func broadcaster(livestream kickapitypes.LivestreamResponseData) kickwebhooktypes.User {
+	return kickwebhooktypes.User{
+		UserID:         livestream.BroadcasterUserID,
+		ProfilePicture: livestream.ProfilePicture,
+		ChannelSlug:    livestream.Slug,
+	}
+}
+
+// Run polls until ctx is done. The interval starts at MinInterval, grows towards MaxInterval while nothing changes
+// or polls fail, and drops back to MinInterval after a change.
+func (w *Watcher) Run(ctx context.Context) error {
+	interval := w.config.MinInterval
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		changed, err := w.Poll(ctx)
+		if err != nil {
+			w.logger.ErrorContext(ctx, "kick: polling livestreams failed", slog.Any("error", err))
+		}
+
+		if changed {
+			interval = w.config.MinInterval
+		} else {
+			interval = min(time.Duration(float64(interval)*backoff), w.config.MaxInterval)
+		}
+		timer.Reset(w.jitter(interval))
+	}
+}
+
+func (w *Watcher) jitter(interval time.Duration) time.Duration {
+	if w.config.Jitter <= 0 {
+		return interval
+	}
+	return time.Duration(float64(interval) * (1 + w.config.Jitter*(2*rand.Float64()-1)))
+}
@@ -0,0 +1,17 @@
+// Package kickmessage parses chat message content into text, emote, mention and URL segments.
+//
+// Emotes are taken from the emote positions Kick sends with ChatMessageSent and from [emote:ID:NAME] markup in the
+// content. Segment offsets are rune offsets, so they stay correct for emoji and other multi-byte text. The parsed
+// message can be rendered as plain text, HTML or Markdown:
+//
+//	webhookClient.RegisterChatMessageSentHandler(func(w http.ResponseWriter, r *http.Request, h kickwebhooktypes.KickWebhookHeaders, message kickwebhooktypes.ChatMessageSent) {
+//		segments := kickmessage.Parse(message)
+//		for _, segment := range segments {
+//			if segment.Type == kickmessage.SegmentEmote {
+//				log.Printf("emote %s at %d: %s", segment.EmoteName, segment.Start, segment.EmoteURL)
+//			}
+//		}
+//		overlay.Push(segments.HTML())
+//		archive.Append(segments.Markdown())
+//	})
+package kickmessage
@@ -0,0 +1,199 @@
+package kickmessage
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/henrikah/kick-go-sdk/v2/kickwebhooktypes"
+)
+
+// SegmentType is the kind of a Segment.
+type SegmentType string
+
+const (
+	// SegmentText is plain text.
+	SegmentText SegmentType = "text"
+	// SegmentEmote is an emote.
+	SegmentEmote SegmentType = "emote"
+	// SegmentMention is an @username mention.
+	SegmentMention SegmentType = "mention"
+	// SegmentURL is a link.
+	SegmentURL SegmentType = "url"
+)
+
+const (
+	// emoteCDN is the URL of the emote images, the emote ID is appended.
+	emoteCDN = "https://files.kick.com/emotes/"
+
+	emoteMarkupPrefix = "[emote:"
+)
+
+// Segment is a part of a chat message.
+type Segment struct {
+	// Type is the kind of the segment.
+	Type SegmentType
+
+	// Text is the content the segment covers, e.g. "[emote:37226:KEKW]" or "@username".
+	Text string
+
+	// Start is the offset of the first rune of the segment in the content.
+	Start int
+
+	// End is the offset of the rune after the segment in the content.
+	End int
+
+	// EmoteID is the ID of the emote of an emote segment.
+	EmoteID string
+
+	// EmoteURL is the CDN URL of the image of an emote segment.
+	EmoteURL string
+
+	// EmoteName is the name of the emote of an emote segment, when the content carries it.
+	EmoteName string
+
+	// Username is the mentioned username without the @ of a mention segment.
+	Username string
+
+	// URL is the link of a URL segment, with https:// added when the text has no scheme.
+	URL string
+}
+
+// Segments is a parsed chat message.
+type Segments []Segment
+
+var tokenPattern = regexp.MustCompile(`\[emote:(\d+):([^\]\s]*)\]|https?://\S+|www\.\S+|@[A-Za-z0-9_]+`)
+
+// EmoteURL returns the CDN URL of the image of the emote.
+func EmoteURL(emoteID string) string {
+	return emoteCDN + emoteID + "/fullsize"
+}
+
+// Parse splits the content of the message into segments, see ParseContent.
+func Parse(message kickwebhooktypes.ChatMessageSent) Segments {
+	return ParseContent(message.Content, message.Emotes)
+}
+
+// ParseContent splits content into text, emote, mention and URL segments. Offsets are in runes, so emoji and
+// other multi-byte characters count as one.
+//
+// Emote positions are rune offsets into content with an inclusive end, as Kick sends them. Positions outside the
+// content or overlapping an earlier emote are ignored. Emote markup in the form [emote:ID:NAME] that is not covered
+// by a position is recognized as an emote as well.
+func ParseContent(content string, emotes []kickwebhooktypes.Emote) Segments {
+	runes := []rune(content)
+
+	var spans []Segment
+	for _, emote := range emotes {
+		for _, position := range emote.Positions {
+			if position.Start < 0 || position.End < position.Start || position.End >= len(runes) {
+				continue
+			}
+			text := string(runes[position.Start : position.End+1])
+			spans = append(spans, Segment{
+				Type:      SegmentEmote,
+				Text:      text,
+				Start:     position.Start,
+				End:       position.End + 1,
+				EmoteID:   emote.EmoteID,
+				EmoteURL:  EmoteURL(emote.EmoteID),
+				EmoteName: emoteName(text),
+			})
+		}
+	}
+	slices.SortStableFunc(spans, func(a, b Segment) int { return a.Start - b.Start })
+
+	var segments Segments
+	offset := 0
+	for _, span := range spans {
+		if span.Start < offset {
+			continue
+		}
+		segments = appendTokens(segments, runes[offset:span.Start], offset)
+		segments = append(segments, span)
+		offset = span.End
+	}
+	segments = appendTokens(segments, runes[offset:], offset)
+
+	return segments
+}
+
+// appendTokens appends the segments of text, which starts at the rune offset in the content.
+func appendTokens(segments Segments, text []rune, offset int) Segments {
+	if len(text) == 0 {
+		return segments
+	}
+
+	str := string(text)
+	last := 0
+	for _, match := range tokenPattern.FindAllStringSubmatchIndex(str, -1) {
+		start, end := match[0], match[1]
+		token := str[start:end]
+
+		var segment Segment
+		switch {
+		case strings.HasPrefix(token, emoteMarkupPrefix):
+			emoteID := str[match[2]:match[3]]
+			segment = Segment{Type: SegmentEmote, EmoteID: emoteID, EmoteURL: EmoteURL(emoteID), EmoteName: str[match[4]:match[5]]}
+		case token[0] == '@':
+			if before, _ := utf8.DecodeLastRuneInString(str[:start]); start > 0 && isWordRune(before) {
+				continue
+			}
+			segment = Segment{Type: SegmentMention, Username: token[1:]}
+		default:
+			end = start + len(strings.TrimRightFunc(token, isTrailingPunctuation))
+			token = str[start:end]
+			segment = Segment{Type: SegmentURL, URL: token}
+			if !strings.HasPrefix(token, "http") {
+				segment.URL = "https://" + token
+			}
+		}
+
+		segments = appendText(segments, str[last:start], offset+utf8.RuneCountInString(str[:last]))
+		segment.Text = token
+		segment.Start = offset + utf8.RuneCountInString(str[:start])
+		segment.End = segment.Start + utf8.RuneCountInString(token)
+		segments = append(segments, segment)
+		last = end
+	}
+
+	return appendText(segments, str[last:], offset+utf8.RuneCountInString(str[:last]))
+}
+
+// appendText appends text starting at the rune offset, merging it into a preceding text segment.
+func appendText(segments Segments, text string, offset int) Segments {
+	if text == "" {
+		return segments
+	}
+
+	if n := len(segments); n > 0 && segments[n-1].Type == SegmentText && segments[n-1].End == offset {
+		segments[n-1].Text += text
+		segments[n-1].End += utf8.RuneCountInString(text)
+		return segments
+	}
+
+	return append(segments, Segment{
+		Type:  SegmentText,
+		Text:  text,
+		Start: offset,
+		End:   offset + utf8.RuneCountInString(text),
+	})
+}
+
+// emoteName returns the name in emote markup, or the text itself when it is not markup.
+func emoteName(text string) string {
+	if match := tokenPattern.FindStringSubmatch(text); match != nil && match[0] == text && strings.HasPrefix(text, emoteMarkupPrefix) {
+		return match[2]
+	}
+	return text
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func isTrailingPunctuation(r rune) bool {
+	return strings.ContainsRune(".,!?;:)]}'\"", r)
+}
@@ -0,0 +1,63 @@
+package kickmessage
+
+import (
+	"html"
+	"strings"
+)
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`,
+	"(", `\(`, ")", `\)`, "<", `\<`, ">", `\>`, "#", `\#`, "|", `\|`, "~", `\~`, "!", `\!`,
+)
+
+// PlainText renders the message as text with emotes replaced by their name.
+func (s Segments) PlainText() string {
+	var builder strings.Builder
+	for _, segment := range s {
+		if segment.Type == SegmentEmote {
+			builder.WriteString(segment.EmoteName)
+			continue
+		}
+		builder.WriteString(segment.Text)
+	}
+	return builder.String()
+}
+
+// HTML renders the message as escaped HTML. Emotes become images, links become anchors opening in a new tab and
+// mentions become spans with the class "kick-mention".
+func (s Segments) HTML() string {
+	var builder strings.Builder
+	for _, segment := range s {
+		switch segment.Type {
+		case SegmentEmote:
+			name := html.EscapeString(segment.EmoteName)
+			builder.WriteString(`<img class="kick-emote" src="` + html.EscapeString(segment.EmoteURL) + `" alt="` + name + `" title="` + name + `">`)
+		case SegmentMention:
+			builder.WriteString(`<span class="kick-mention">` + html.EscapeString(segment.Text) + `</span>`)
+		case SegmentURL:
+			builder.WriteString(`<a href="` + html.EscapeString(segment.URL) + `" rel="nofollow noopener noreferrer" target="_blank">` + html.EscapeString(segment.Text) + `</a>`)
+		default:
+			builder.WriteString(html.EscapeString(segment.Text))
+		}
+	}
+	return builder.String()
+}
+
+// Markdown renders the message as Markdown with escaped text. Emotes become images, links become autolinks and
+// mentions are bold.
+func (s Segments) Markdown() string {
+	var builder strings.Builder
+	for _, segment := range s {
+		switch segment.Type {
+		case SegmentEmote:
+			builder.WriteString("![" + markdownEscaper.Replace(segment.EmoteName) + "](" + segment.EmoteURL + ")")
+		case SegmentMention:
+			builder.WriteString("**" + markdownEscaper.Replace(segment.Text) + "**")
+		case SegmentURL:
+			builder.WriteString("<" + strings.ReplaceAll(segment.URL, ">", "%3E") + ">")
+		default:
+			builder.WriteString(markdownEscaper.Replace(segment.Text))
+		}
+	}
+	return builder.String()
+}
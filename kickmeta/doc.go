@@ -0,0 +1,15 @@
+// Package kickmeta collects the response metadata of the requests made by a service method.
+//
+// Service methods return only the decoded response. To also get the status code, headers, duration,
+// attempt count and URL of the underlying requests, attach a Collector to the context of the call:
+//
+//	ctx, collector := kickmeta.WithCollector(ctx)
+//
+//	response, err := apiClient.Chat().SendChatMessageAsBot(ctx, accessToken, nil, "Hello!")
+//
+//	if meta, ok := collector.Last(); ok {
+//		log.Printf("%s took %s, %s requests remaining", meta.Operation, meta.Duration, meta.Header.Get("X-RateLimit-Remaining"))
+//	}
+//
+// Methods that split their input into several requests, such as GetAllUsersByID, record one ResponseMeta per request.
+package kickmeta
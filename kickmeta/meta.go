@@ -0,0 +1,75 @@
+package kickmeta
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ResponseMeta describes a single request made by a service method.
+type ResponseMeta struct {
+	// Operation is the service method that made the request, e.g. "Chat.SendChatMessageAsBot".
+	Operation string
+	// StatusCode is the HTTP status code of the response, 0 when no response was received.
+	StatusCode int
+	// Header holds the response headers, nil when no response was received.
+	Header http.Header
+	// Duration is the time from sending the request until the response headers were received.
+	Duration time.Duration
+	// Attempts is the number of times the request was sent.
+	Attempts int
+	// RequestURL is the URL of the request, including the query.
+	RequestURL string
+}
+
+// Collector records the ResponseMeta of every request made with its context. It is safe for concurrent use.
+type Collector struct {
+	mu        sync.Mutex
+	responses []ResponseMeta
+}
+
+type collectorKey struct{}
+
+// WithCollector returns a copy of ctx with a new Collector attached.
+func WithCollector(ctx context.Context) (context.Context, *Collector) {
+	collector := &Collector{}
+	return context.WithValue(ctx, collectorKey{}, collector), collector
+}
+
+// FromContext returns the Collector attached to ctx, or nil.
+func FromContext(ctx context.Context) *Collector {
+	collector, _ := ctx.Value(collectorKey{}).(*Collector)
+	return collector
+}
+
+// Record adds the metadata of a request to the collector.
+func (c *Collector) Record(meta ResponseMeta) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.responses = append(c.responses, meta)
+}
+
+// Last returns the metadata of the most recently completed request.
+func (c *Collector) Last() (ResponseMeta, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.responses) == 0 {
+		return ResponseMeta{}, false
+	}
+	return c.responses[len(c.responses)-1], true
+}
+
+// All returns the metadata of every request in the order they completed.
+func (c *Collector) All() []ResponseMeta {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]ResponseMeta(nil), c.responses...)
+}
+
+// Reset removes all recorded metadata so the collector can be reused for the next call.
+func (c *Collector) Reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.responses = nil
+}
@@ -0,0 +1,13 @@
+// Package kickmoderation contains the typed request builders for bans and timeouts.
+//
+// Timeouts take a time.Duration and are converted to the whole minutes Kick expects, so a timeout of
+// 10*time.Minute is 10 minutes and never 10 seconds or 10 days:
+//
+//	request := kickmoderation.NewTimeout(broadcasterUserID, userID, 10*time.Minute).
+//	    WithReason("spam")
+//
+//	moderationResponse, err := client.Moderation().Moderate(context.TODO(), accessToken, request)
+//	if err != nil {
+//	    log.Printf("could not time out user: %v", err)
+//	}
+package kickmoderation
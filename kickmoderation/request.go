@@ -0,0 +1,110 @@
+package kickmoderation
+
+import (
+	"time"
+
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+)
+
+// MaxTimeout is the longest timeout Kick accepts.
+const MaxTimeout = 10080 * time.Minute
+
+// Request is a fluent builder for a ban or timeout.
+type Request interface {
+	// WithReason sets the reason shown to moderators.
+	WithReason(reason string) Request
+
+	// ToRequest converts the builder into the request body for the API call.
+	//
+	// Returns an error if any validation fails (e.g., invalid IDs or a timeout out of range).
+	ToRequest() (kickapitypes.ModerationRequest, error)
+}
+
+type request struct {
+	broadcasterUserID int
+	userID            int
+	duration          *time.Duration
+	reason            *string
+}
+
+// NewBan creates a new builder for a permanent ban of the user in the channel of the broadcaster.
+//
+// Example:
+//
+//	request := kickmoderation.NewBan(broadcasterUserID, userID).
+//	    WithReason("spam bot")
+//
+//	moderationResponse, err := client.Moderation().Moderate(context.TODO(), accessToken, request)
+func NewBan(broadcasterUserID, userID int) Request {
+	return &request{
+		broadcasterUserID: broadcasterUserID,
+		userID:            userID,
+	}
+}
+
+// NewTimeout creates a new builder for a timeout of the user in the channel of the broadcaster.
+//
+// The duration must be at least a minute and at most MaxTimeout. Kick takes timeouts in whole minutes, so a
+// partial minute is rounded up, e.g. 90 seconds become 2 minutes.
+//
+// Example:
+//
+//	request := kickmoderation.NewTimeout(broadcasterUserID, userID, 10*time.Minute).
+//	    WithReason("caps")
+//
+//	moderationResponse, err := client.Moderation().Moderate(context.TODO(), accessToken, request)
+func NewTimeout(broadcasterUserID, userID int, duration time.Duration) Request {
+	return &request{
+		broadcasterUserID: broadcasterUserID,
+		userID:            userID,
+		duration:          &duration,
+	}
+}
+
+func (r *request) WithReason(reason string) Request {
+	r.reason = &reason
+	return r
+}
+
+func (r *request) ToRequest() (kickapitypes.ModerationRequest, error) {
+	if err := kickerrors.ValidateBroadcasterUserID(r.broadcasterUserID); err != nil {
+		return kickapitypes.ModerationRequest{}, err
+	}
+	if err := kickerrors.ValidateUserID(r.userID); err != nil {
+		return kickapitypes.ModerationRequest{}, err
+	}
+
+	moderationRequest := kickapitypes.ModerationRequest{
+		BroadcasterUserID: r.broadcasterUserID,
+		Reason:            r.reason,
+		UserID:            r.userID,
+	}
+
+	if r.duration != nil {
+		if err := ValidateTimeout("duration", *r.duration); err != nil {
+			return kickapitypes.ModerationRequest{}, err
+		}
+		minutes := Minutes(*r.duration)
+		moderationRequest.Duration = &minutes
+	}
+
+	return moderationRequest, nil
+}
+
+// Minutes converts the duration to the whole minutes Kick takes, rounding up.
+func Minutes(duration time.Duration) int {
+	return int((duration + time.Minute - 1) / time.Minute)
+}
+
+// ValidateTimeout returns a *kickerrors.ValidationError for field when the duration is shorter than a minute or
+// longer than MaxTimeout.
+func ValidateTimeout(field string, duration time.Duration) error {
+	if duration < time.Minute || duration > MaxTimeout {
+		return &kickerrors.ValidationError{
+			Field:   field,
+			Message: "must be between 1 minute and 7 days, got " + duration.String(),
+		}
+	}
+	return nil
+}
@@ -1,11 +1,28 @@
 package kickoauthtypes
 
 import (
+	"log/slog"
+
 	"github.com/henrikah/kick-go-sdk/v2/internal/httpclient"
+	"github.com/henrikah/kick-go-sdk/v2/kickinterceptor"
+	"github.com/henrikah/kick-go-sdk/v2/kicktelemetry"
 )
 
 type OAuthClientConfig struct {
 	ClientID     string
 	ClientSecret string
 	HTTPClient   httpclient.ClientInterface
+
+	// Logger receives request and response logs with tokens and client secrets redacted.
+	// Defaults to slog.Default() when nil.
+	Logger *slog.Logger
+
+	// Tracer starts a client span for every API request. Telemetry is skipped when nil.
+	Tracer kicktelemetry.Tracer
+
+	// Metrics records the duration and outcome of every API request. Telemetry is skipped when nil.
+	Metrics kicktelemetry.MetricsRecorder
+
+	// Interceptors wrap every OAuth request, the first interceptor is the outermost.
+	Interceptors []kickinterceptor.Interceptor
 }
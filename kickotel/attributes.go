@@ -0,0 +1,28 @@
+package kickotel
+
+import (
+	"github.com/henrikah/kick-go-sdk/v2/kicktelemetry"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// Attribute keys follow the OpenTelemetry HTTP semantic conventions where one exists.
+const (
+	operationKey      = "kick.operation"
+	methodKey         = "http.request.method"
+	endpointKey       = "url.template"
+	statusCodeKey     = "http.response.status_code"
+	retryCountKey     = "http.request.resend_count"
+	eventTypeKey      = "kick.event.type"
+	eventVersionKey   = "kick.event.version"
+	messageIDKey      = "kick.event.message_id"
+	subscriptionIDKey = "kick.event.subscription_id"
+	verifiedKey       = "kick.webhook.verified"
+)
+
+func requestAttributes(info kicktelemetry.RequestInfo) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String(operationKey, info.Operation),
+		attribute.String(methodKey, info.Method),
+		attribute.String(endpointKey, info.Endpoint),
+	}
+}
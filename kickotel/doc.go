@@ -0,0 +1,23 @@
+// Package kickotel implements the kicktelemetry hooks on top of OpenTelemetry.
+//
+// It lives in its own module so the SDK does not depend on OpenTelemetry unless kickotel is imported.
+// kickotel requires v2.2.0 or later of the SDK, the first release with kicktelemetry. The SDK is released first,
+// then kickotel is tagged as kickotel/vX.Y.Z.
+//
+//	metrics, err := kickotel.NewMetricsRecorder(otel.GetMeterProvider())
+//	if err != nil {
+//		log.Fatalf("could not create metrics recorder: %v", err)
+//	}
+//
+//	apiClient, err := kick.NewAPIClient(kickapitypes.APIClientConfig{
+//		HTTPClient: http.DefaultClient,
+//		Tracer:     kickotel.NewTracer(otel.GetTracerProvider()),
+//		Metrics:    metrics,
+//	})
+//	if err != nil {
+//		log.Fatalf("could not create APIClient: %v", err)
+//	}
+//
+// API requests produce a client span named after the service method, e.g. "Chat.SendChatMessageAsBot".
+// Webhooks produce a server span named after the event type, e.g. "webhook chat.message.sent".
+package kickotel
@@ -0,0 +1,25 @@
+module github.com/henrikah/kick-go-sdk/v2/kickotel
+
+go 1.24.0
+
+require (
+	github.com/henrikah/kick-go-sdk/v2 v2.2.0
+	go.opentelemetry.io/otel v1.40.0
+	go.opentelemetry.io/otel/metric v1.40.0
+	go.opentelemetry.io/otel/sdk v1.40.0
+	go.opentelemetry.io/otel/sdk/metric v1.40.0
+	go.opentelemetry.io/otel/trace v1.40.0
+)
+
+require (
+	github.com/cespare/xxhash/v2 v2.3.0 // indirect
+	github.com/go-logr/logr v1.4.3 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	go.opentelemetry.io/auto/sdk v1.2.1 // indirect
+	golang.org/x/sys v0.40.0 // indirect
+)
+
+// The replace builds kickotel against the SDK in this repository during development. Consumers ignore it, so
+// the required SDK version must be released before kickotel is tagged.
+replace github.com/henrikah/kick-go-sdk/v2 => ../
@@ -0,0 +1,159 @@
+package kickotel_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/henrikah/kick-go-sdk/v2/kickotel"
+	"github.com/henrikah/kick-go-sdk/v2/kicktelemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func hasAttribute(attributes []attribute.KeyValue, expected attribute.KeyValue) bool {
+	for _, attr := range attributes {
+		if attr == expected {
+			return true
+		}
+	}
+	return false
+}
+
+func Test_TracerRequestSpan_Success(t *testing.T) {
+	// Arrange
+	recorder := tracetest.NewSpanRecorder()
+	tracer := kickotel.NewTracer(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	// Act
+	ctx, span := tracer.StartRequestSpan(context.Background(), kicktelemetry.RequestInfo{
+		Operation: "Chat.SendChatMessageAsBot",
+		Method:    http.MethodPost,
+		Endpoint:  "public/v1/chat",
+	})
+	span.End(kicktelemetry.RequestResult{StatusCode: http.StatusOK})
+
+	// Assert
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		t.Fatal("Expected context to carry the span")
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("Expected 1 span, got %d", len(spans))
+	}
+
+	ended := spans[0]
+	if ended.Name() != "Chat.SendChatMessageAsBot" || ended.SpanKind() != trace.SpanKindClient {
+		t.Fatalf("Expected client span named after the operation, got %q (%s)", ended.Name(), ended.SpanKind())
+	}
+
+	for _, expected := range []attribute.KeyValue{
+		attribute.String("url.template", "public/v1/chat"),
+		attribute.Int("http.response.status_code", http.StatusOK),
+		attribute.Int("http.request.resend_count", 0),
+	} {
+		if !hasAttribute(ended.Attributes(), expected) {
+			t.Fatalf("Expected attribute %v, got %v", expected, ended.Attributes())
+		}
+	}
+
+	if ended.Status().Code == codes.Error {
+		t.Fatal("Expected span to not be an error")
+	}
+}
+
+func Test_TracerWebhookSpanUnverified_Error(t *testing.T) {
+	// Arrange
+	recorder := tracetest.NewSpanRecorder()
+	tracer := kickotel.NewTracer(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	// Act
+	_, span := tracer.StartWebhookSpan(context.Background(), kicktelemetry.WebhookInfo{
+		EventType: "chat.message.sent",
+		MessageID: "message-1",
+	})
+	span.End(kicktelemetry.WebhookResult{Err: errors.New("invalid signature")})
+
+	// Assert
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("Expected 1 span, got %d", len(spans))
+	}
+
+	ended := spans[0]
+	if ended.Name() != "webhook chat.message.sent" || ended.SpanKind() != trace.SpanKindServer {
+		t.Fatalf("Expected server span named after the event type, got %q (%s)", ended.Name(), ended.SpanKind())
+	}
+
+	for _, expected := range []attribute.KeyValue{
+		attribute.String("kick.event.message_id", "message-1"),
+		attribute.Bool("kick.webhook.verified", false),
+	} {
+		if !hasAttribute(ended.Attributes(), expected) {
+			t.Fatalf("Expected attribute %v, got %v", expected, ended.Attributes())
+		}
+	}
+
+	if ended.Status().Code != codes.Error {
+		t.Fatal("Expected span to be an error")
+	}
+}
+
+func Test_MetricsRecorder_Success(t *testing.T) {
+	// Arrange
+	reader := sdkmetric.NewManualReader()
+	metrics, err := kickotel.NewMetricsRecorder(sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	info := kicktelemetry.RequestInfo{Operation: "User.GetUsersByID", Method: http.MethodGet, Endpoint: "public/v1/users"}
+
+	// Act
+	metrics.RecordRequest(context.Background(), info, kicktelemetry.RequestResult{StatusCode: http.StatusOK, Duration: time.Second})
+	metrics.RecordRequest(context.Background(), info, kicktelemetry.RequestResult{StatusCode: http.StatusTooManyRequests, Err: errors.New("slow down")})
+	metrics.RecordWebhook(context.Background(), kicktelemetry.WebhookInfo{EventType: "channel.followed"}, kicktelemetry.WebhookResult{Verified: true})
+
+	// Assert
+	var data metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &data); err != nil {
+		t.Fatal(err)
+	}
+
+	collected := map[string]metricdata.Metrics{}
+	for _, scope := range data.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			collected[m.Name] = m
+		}
+	}
+
+	duration, ok := collected["kick.client.request.duration"].Data.(metricdata.Histogram[float64])
+	if !ok || len(duration.DataPoints) != 2 {
+		t.Fatalf("Expected request durations for 2 status codes, got %+v", collected["kick.client.request.duration"].Data)
+	}
+
+	failures, ok := collected["kick.client.request.failures"].Data.(metricdata.Sum[int64])
+	if !ok || len(failures.DataPoints) != 1 || failures.DataPoints[0].Value != 1 {
+		t.Fatalf("Expected 1 failed request, got %+v", collected["kick.client.request.failures"].Data)
+	}
+
+	if status, _ := failures.DataPoints[0].Attributes.Value("http.response.status_code"); status.AsInt64() != http.StatusTooManyRequests {
+		t.Fatalf("Expected failure with status 429, got %v", status)
+	}
+
+	webhooks, ok := collected["kick.webhook.events"].Data.(metricdata.Sum[int64])
+	if !ok || len(webhooks.DataPoints) != 1 {
+		t.Fatalf("Expected 1 webhook event, got %+v", collected["kick.webhook.events"].Data)
+	}
+
+	if eventType, _ := webhooks.DataPoints[0].Attributes.Value("kick.event.type"); eventType.AsString() != "channel.followed" {
+		t.Fatalf("Expected webhook event by type, got %v", eventType)
+	}
+}
@@ -0,0 +1,74 @@
+package kickotel
+
+import (
+	"context"
+
+	"github.com/henrikah/kick-go-sdk/v2/kicktelemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+type metricsRecorder struct {
+	requestDuration metric.Float64Histogram
+	requestFailures metric.Int64Counter
+	webhookEvents   metric.Int64Counter
+}
+
+// NewMetricsRecorder returns a kicktelemetry.MetricsRecorder that records to meters from the given provider.
+//
+// It records the following instruments:
+//
+//   - kick.client.request.duration, a histogram of API request durations in seconds.
+//   - kick.client.request.failures, a counter of failed API requests by status code.
+//   - kick.webhook.events, a counter of incoming webhooks by event type and verification result.
+func NewMetricsRecorder(provider metric.MeterProvider) (kicktelemetry.MetricsRecorder, error) {
+	meter := provider.Meter(instrumentationName)
+
+	requestDuration, err := meter.Float64Histogram("kick.client.request.duration",
+		metric.WithDescription("Duration of Kick API requests."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	requestFailures, err := meter.Int64Counter("kick.client.request.failures",
+		metric.WithDescription("Number of failed Kick API requests."),
+		metric.WithUnit("{request}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	webhookEvents, err := meter.Int64Counter("kick.webhook.events",
+		metric.WithDescription("Number of incoming Kick webhooks."),
+		metric.WithUnit("{event}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &metricsRecorder{
+		requestDuration: requestDuration,
+		requestFailures: requestFailures,
+		webhookEvents:   webhookEvents,
+	}, nil
+}
+
+func (m *metricsRecorder) RecordRequest(ctx context.Context, info kicktelemetry.RequestInfo, result kicktelemetry.RequestResult) {
+	attributes := append(requestAttributes(info), attribute.Int(statusCodeKey, result.StatusCode))
+	attributeSet := metric.WithAttributeSet(attribute.NewSet(attributes...))
+
+	m.requestDuration.Record(ctx, result.Duration.Seconds(), attributeSet)
+
+	if result.Err != nil {
+		m.requestFailures.Add(ctx, 1, attributeSet)
+	}
+}
+
+func (m *metricsRecorder) RecordWebhook(ctx context.Context, info kicktelemetry.WebhookInfo, result kicktelemetry.WebhookResult) {
+	m.webhookEvents.Add(ctx, 1, metric.WithAttributes(
+		attribute.String(eventTypeKey, info.EventType),
+		attribute.Bool(verifiedKey, result.Verified),
+	))
+}
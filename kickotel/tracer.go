@@ -0,0 +1,85 @@
+package kickotel
+
+import (
+	"context"
+
+	"github.com/henrikah/kick-go-sdk/v2/kicktelemetry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName is the name of the tracer and meter used by kickotel.
+const instrumentationName = "github.com/henrikah/kick-go-sdk/v2/kickotel"
+
+type tracer struct {
+	tracer trace.Tracer
+}
+
+// NewTracer returns a kicktelemetry.Tracer that starts spans from the given provider.
+func NewTracer(provider trace.TracerProvider) kicktelemetry.Tracer {
+	return &tracer{
+		tracer: provider.Tracer(instrumentationName),
+	}
+}
+
+func (t *tracer) StartRequestSpan(ctx context.Context, info kicktelemetry.RequestInfo) (context.Context, kicktelemetry.RequestSpan) {
+	name := info.Operation
+	if name == "" {
+		name = info.Method + " " + info.Endpoint
+	}
+
+	ctx, span := t.tracer.Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(requestAttributes(info)...),
+	)
+
+	return ctx, requestSpan{span: span}
+}
+
+func (t *tracer) StartWebhookSpan(ctx context.Context, info kicktelemetry.WebhookInfo) (context.Context, kicktelemetry.WebhookSpan) {
+	ctx, span := t.tracer.Start(ctx, "webhook "+info.EventType,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			attribute.String(eventTypeKey, info.EventType),
+			attribute.String(eventVersionKey, info.EventVersion),
+			attribute.String(messageIDKey, info.MessageID),
+			attribute.String(subscriptionIDKey, info.SubscriptionID),
+		),
+	)
+
+	return ctx, webhookSpan{span: span}
+}
+
+type requestSpan struct {
+	span trace.Span
+}
+
+func (s requestSpan) End(result kicktelemetry.RequestResult) {
+	if result.StatusCode != 0 {
+		s.span.SetAttributes(attribute.Int(statusCodeKey, result.StatusCode))
+	}
+	s.span.SetAttributes(attribute.Int(retryCountKey, result.RetryCount))
+
+	if result.Err != nil {
+		s.span.RecordError(result.Err)
+		s.span.SetStatus(codes.Error, result.Err.Error())
+	}
+
+	s.span.End()
+}
+
+type webhookSpan struct {
+	span trace.Span
+}
+
+func (s webhookSpan) End(result kicktelemetry.WebhookResult) {
+	s.span.SetAttributes(attribute.Bool(verifiedKey, result.Verified))
+
+	if result.Err != nil {
+		s.span.RecordError(result.Err)
+		s.span.SetStatus(codes.Error, result.Err.Error())
+	}
+
+	s.span.End()
+}
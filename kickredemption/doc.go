@@ -0,0 +1,53 @@
+// Package kickredemption decides channel reward redemptions automatically and reports on them.
+//
+// A Processor runs the handler registered for the reward of every pending redemption. The handler inspects the
+// redemption, e.g. its UserInput, and accepts it, rejects it or leaves it pending for a human. Decisions are queued
+// and sent in batches of at most 25 redemptions, failed calls are retried. Redemptions arrive through the
+// ChannelRewardRedemptionUpdated webhook and are recovered by polling the pending redemptions of the channels:
+//
+//	processor, err := kickredemption.NewProcessor(kickredemption.ProcessorConfig{
+//		ChannelReward: apiClient.ChannelReward(),
+//		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+//			return tokens[broadcasterUserID], nil
+//		},
+//		Broadcasters: []int{12345},
+//	})
+//	if err != nil {
+//		log.Fatalf("could not create processor: %v", err)
+//	}
+//
+//	err = processor.HandleTitle("Song request", func(ctx context.Context, redemption kickredemption.Redemption) (kickredemption.Decision, error) {
+//		if !strings.HasPrefix(redemption.UserInput, "https://") {
+//			return kickredemption.DecisionReject, nil
+//		}
+//		return kickredemption.DecisionAccept, nil
+//	})
+//	if err != nil {
+//		log.Fatalf("could not register handler: %v", err)
+//	}
+//
+//	webhookClient.RegisterChannelRewardRedemptionUpdatedHandler(processor.WebhookHandler())
+//
+//	go processor.Run(ctx)
+//
+// A Reporter pages through the redemptions of every status and aggregates them by reward, redeemer and status over
+// a time range, for export as CSV or JSON:
+//
+//	reporter, err := kickredemption.NewReporter(kickredemption.ReporterConfig{
+//		ChannelReward: apiClient.ChannelReward(),
+//		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+//			return tokens[broadcasterUserID], nil
+//		},
+//	})
+//	if err != nil {
+//		log.Fatalf("could not create reporter: %v", err)
+//	}
+//
+//	since := time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC)
+//	report, err := reporter.Report(ctx, 12345, since, since.AddDate(0, 1, 0))
+//	if err != nil {
+//		log.Fatalf("could not build report: %v", err)
+//	}
+//
+//	err = report.WriteCSV(os.Stdout)
+package kickredemption
@@ -0,0 +1,414 @@
+package kickredemption
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/henrikah/kick-go-sdk/v2/enums/kickchannelrewardstatus"
+	"github.com/henrikah/kick-go-sdk/v2/internal/batch"
+	"github.com/henrikah/kick-go-sdk/v2/internal/logging"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickcontracts"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/kickfilters"
+	"github.com/henrikah/kick-go-sdk/v2/kickwebhooktypes"
+)
+
+const (
+	defaultFlushInterval = time.Second
+	defaultPollInterval  = 5 * time.Minute
+	defaultMaxAttempts   = 3
+
+	// maxDecisionIDs is the most redemption IDs Kick accepts in one accept or reject call.
+	maxDecisionIDs = 25
+
+	// seenRetention is how long a handled redemption is remembered so polling does not ask its handler again.
+	seenRetention = 24 * time.Hour
+)
+
+// Decision is what a handler decides for a redemption.
+type Decision string
+
+const (
+	// DecisionPending leaves the redemption in the queue for a human to decide.
+	DecisionPending Decision = "pending"
+	// DecisionAccept accepts the redemption.
+	DecisionAccept Decision = "accept"
+	// DecisionReject rejects the redemption and refunds the points.
+	DecisionReject Decision = "reject"
+)
+
+// Redemption is a pending redemption, from a webhook or from polling.
+type Redemption struct {
+	ID                string
+	BroadcasterUserID int
+	RewardID          string
+	RewardTitle       string
+	UserInput         string
+	RedeemerUserID    int
+
+	// RedeemerUsername is empty for redemptions recovered by polling.
+	RedeemerUsername string
+
+	RedeemedAt time.Time
+}
+
+// Handler decides a redemption of a reward. A returned error leaves the redemption undecided, it is handled again
+// when it is next polled.
+type Handler func(ctx context.Context, redemption Redemption) (Decision, error)
+
+// ProcessorConfig configures a Processor.
+type ProcessorConfig struct {
+	// ChannelReward is the service used to poll, accept and reject redemptions.
+	ChannelReward kickcontracts.ChannelReward
+
+	// AccessToken returns the access token of the broadcaster used to manage the redemptions of the channel.
+	AccessToken func(ctx context.Context, broadcasterUserID int) (string, error)
+
+	// Broadcasters are polled by Run for pending redemptions missed while the processor was down.
+	Broadcasters []int
+
+	// FlushInterval is how often Run sends the queued decisions. Defaults to 1 second when zero.
+	FlushInterval time.Duration
+
+	// PollInterval is how often Run polls the broadcasters. Defaults to 5 minutes when zero.
+	PollInterval time.Duration
+
+	// MaxAttempts is how often a decision is sent before it is dropped. Defaults to 3 when zero.
+	MaxAttempts int
+
+	// Logger receives failed handlers, polls and flushes. Defaults to slog.Default() when nil.
+	Logger *slog.Logger
+}
+
+type queueKey struct {
+	broadcasterUserID int
+	decision          Decision
+}
+
+type queuedDecision struct {
+	redemptionID string
+	attempts     int
+}
+
+// Processor decides reward redemptions with per-reward handlers and sends the decisions in batches.
+// It is safe for concurrent use.
+type Processor struct {
+	config   ProcessorConfig
+	logger   *slog.Logger
+	flushing sync.Mutex
+
+	mu      sync.Mutex
+	byID    map[string]Handler
+	byTitle map[string]Handler
+	queue   map[queueKey][]queuedDecision
+	seen    map[string]time.Time
+}
+
+// NewProcessor creates a new Processor with the provided configuration.
+func NewProcessor(config ProcessorConfig) (*Processor, error) {
+	if err := kickerrors.ValidateNotNil("ChannelReward", config.ChannelReward); err != nil {
+		return nil, err
+	}
+	if err := kickerrors.ValidateNotNil("AccessToken", config.AccessToken); err != nil {
+		return nil, err
+	}
+	for _, broadcasterUserID := range config.Broadcasters {
+		if err := kickerrors.ValidateBroadcasterUserID(broadcasterUserID); err != nil {
+			return nil, err
+		}
+	}
+
+	config.FlushInterval = cmp.Or(config.FlushInterval, defaultFlushInterval)
+	config.PollInterval = cmp.Or(config.PollInterval, defaultPollInterval)
+	config.MaxAttempts = cmp.Or(config.MaxAttempts, defaultMaxAttempts)
+
+	return &Processor{
+		config:  config,
+		logger:  logging.OrDefault(config.Logger),
+		byID:    map[string]Handler{},
+		byTitle: map[string]Handler{},
+		queue:   map[queueKey][]queuedDecision{},
+		seen:    map[string]time.Time{},
+	}, nil
+}
+
+// Handle registers the handler for the reward with the ID.
+func (p *Processor) Handle(rewardID string, handler Handler) error {
+	return p.register(p.byID, "rewardID", rewardID, handler)
+}
+
+// HandleTitle registers the handler for the rewards with the title in every channel, ignoring case. Handlers
+// registered by ID take precedence.
+func (p *Processor) HandleTitle(title string, handler Handler) error {
+	return p.register(p.byTitle, "title", strings.ToLower(title), handler)
+}
+
+func (p *Processor) register(handlers map[string]Handler, field, key string, handler Handler) error {
+	if err := kickerrors.ValidateNotEmpty(field, key); err != nil {
+		return err
+	}
+	if err := kickerrors.ValidateNotNil("handler", handler); err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, exists := handlers[key]; exists {
+		return &kickerrors.ValidationError{Field: field, Message: fmt.Sprintf("a handler for %q is already registered", key)}
+	}
+	handlers[key] = handler
+	return nil
+}
+
+// Process decides the redemption with the handler of its reward and queues the decision for the next Flush.
+// Every redemption is handled once, so a redemption the handler left pending is not handled again when it is
+// polled. Redemptions without a handler are left pending.
+func (p *Processor) Process(ctx context.Context, redemption Redemption) (Decision, error) {
+	if err := kickerrors.ValidateBroadcasterUserID(redemption.BroadcasterUserID); err != nil {
+		return DecisionPending, err
+	}
+	if err := kickerrors.ValidateNotEmpty("ID", redemption.ID); err != nil {
+		return DecisionPending, err
+	}
+
+	p.mu.Lock()
+	handler, ok := p.byID[redemption.RewardID]
+	if !ok {
+		handler, ok = p.byTitle[strings.ToLower(redemption.RewardTitle)]
+	}
+	_, handled := p.seen[redemption.ID]
+	if ok && !handled {
+		p.seen[redemption.ID] = time.Now()
+	}
+	p.mu.Unlock()
+
+	if !ok || handled {
+		return DecisionPending, nil
+	}
+
+	decision, err := handler(ctx, redemption)
+	if err == nil && decision != DecisionAccept && decision != DecisionReject && decision != DecisionPending {
+		err = &kickerrors.ValidationError{Field: "Decision", Message: fmt.Sprintf("unknown decision %q", decision)}
+	}
+	if err != nil {
+		p.mu.Lock()
+		delete(p.seen, redemption.ID)
+		p.mu.Unlock()
+		return DecisionPending, err
+	}
+
+	if decision != DecisionPending {
+		p.mu.Lock()
+		key := queueKey{broadcasterUserID: redemption.BroadcasterUserID, decision: decision}
+		p.queue[key] = append(p.queue[key], queuedDecision{redemptionID: redemption.ID})
+		p.mu.Unlock()
+	}
+
+	return decision, nil
+}
+
+// WebhookHandler returns a handler for RegisterChannelRewardRedemptionUpdatedHandler that processes every pending
+// redemption. Handler errors are logged.
+func (p *Processor) WebhookHandler() func(http.ResponseWriter, *http.Request, kickwebhooktypes.KickWebhookHeaders, kickwebhooktypes.ChannelRewardRedemptionUpdated) {
+	return func(writer http.ResponseWriter, request *http.Request, kickHeaders kickwebhooktypes.KickWebhookHeaders, event kickwebhooktypes.ChannelRewardRedemptionUpdated) {
+		if event.Status == kickchannelrewardstatus.Pending {
+			redemption := Redemption{
+				ID:                event.ID,
+				BroadcasterUserID: event.Broadcaster.UserID,
+				RewardID:          event.Reward.ID,
+				RewardTitle:       event.Reward.Title,
+				UserInput:         event.UserInput,
+				RedeemerUserID:    event.Redeemer.UserID,
+				RedeemerUsername:  event.Redeemer.Username,
+				RedeemedAt:        event.RedeemedAt.Time(),
+			}
+			if _, err := p.Process(request.Context(), redemption); err != nil {
+				p.logger.ErrorContext(request.Context(), "kick: processing redemption failed",
+					slog.String("message_id", kickHeaders.MessageID),
+					slog.String("redemption_id", event.ID),
+					slog.Any("error", err),
+				)
+			}
+		}
+		writer.WriteHeader(http.StatusOK)
+	}
+}
+
+// Poll processes every pending redemption of the channel of the broadcaster, so redemptions missed while the
+// processor was down are decided as well. Handler errors are logged.
+func (p *Processor) Poll(ctx context.Context, broadcasterUserID int) error {
+	if err := kickerrors.ValidateBroadcasterUserID(broadcasterUserID); err != nil {
+		return err
+	}
+
+	accessToken, err := p.config.AccessToken(ctx, broadcasterUserID)
+	if err != nil {
+		return err
+	}
+
+	cursor := ""
+	for {
+		filters := kickfilters.NewRewardRedemptionsFilter().WithStatus(kickchannelrewardstatus.Pending)
+		if cursor != "" {
+			filters.WithCursor(cursor)
+		}
+
+		page, err := p.config.ChannelReward.GetChannelRewardRedemptions(ctx, accessToken, filters)
+		if err != nil {
+			return err
+		}
+
+		for _, reward := range page.Data {
+			for _, redemptionData := range reward.Redemptions {
+				if redemptionData.Status != "" && redemptionData.Status != kickchannelrewardstatus.Pending {
+					continue
+				}
+				redemption := Redemption{
+					ID:                redemptionData.ID,
+					BroadcasterUserID: broadcasterUserID,
+					RewardID:          reward.Reward.ID,
+					RewardTitle:       reward.Reward.Title,
+					UserInput:         redemptionData.UserInput,
+					RedeemerUserID:    int(redemptionData.Redeemer.BroadcasterUserID),
+					RedeemedAt:        redemptionData.RedeemedAt.Time(),
+				}
+				if _, err := p.Process(ctx, redemption); err != nil {
+					p.logger.ErrorContext(ctx, "kick: processing redemption failed",
+						slog.String("redemption_id", redemption.ID),
+						slog.Any("error", err),
+					)
+				}
+			}
+		}
+
+		if page.Pagination.NextCursor == "" || page.Pagination.NextCursor == cursor {
+			return nil
+		}
+		cursor = page.Pagination.NextCursor
+	}
+}
+
+// Flush sends the queued decisions in calls of at most 25 redemptions. Decisions of failed calls are queued again
+// until they were sent MaxAttempts times, after which they are dropped and their errors returned.
+func (p *Processor) Flush(ctx context.Context) error {
+	p.flushing.Lock()
+	defer p.flushing.Unlock()
+
+	p.mu.Lock()
+	queue := p.queue
+	p.queue = map[queueKey][]queuedDecision{}
+	now := time.Now()
+	for id, handledAt := range p.seen {
+		if now.Sub(handledAt) > seenRetention {
+			delete(p.seen, id)
+		}
+	}
+	p.mu.Unlock()
+
+	var errs []error
+	for key, decisions := range queue {
+		for _, chunk := range batch.Chunk(decisions, maxDecisionIDs) {
+			if err := p.send(ctx, key, chunk); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (p *Processor) send(ctx context.Context, key queueKey, chunk []queuedDecision) error {
+	ids := make([]string, len(chunk))
+	for i, queued := range chunk {
+		ids[i] = queued.redemptionID
+	}
+
+	accessToken, err := p.config.AccessToken(ctx, key.broadcasterUserID)
+	if err == nil {
+		var response *kickapitypes.RedemptionDecision
+		if key.decision == DecisionAccept {
+			response, err = p.config.ChannelReward.AcceptRewardRedemption(ctx, accessToken, ids)
+		} else {
+			response, err = p.config.ChannelReward.RejectRewardRedemption(ctx, accessToken, ids)
+		}
+		if err == nil {
+			for _, failed := range response.Data {
+				p.logger.WarnContext(ctx, "kick: redemption decision not applied",
+					slog.String("redemption_id", failed.ID),
+					slog.String("decision", string(key.decision)),
+					slog.String("reason", failed.Reason),
+				)
+			}
+			return nil
+		}
+	}
+
+	var retry []queuedDecision
+	var dropped []string
+	for _, queued := range chunk {
+		queued.attempts++
+		if queued.attempts < p.config.MaxAttempts {
+			retry = append(retry, queued)
+		} else {
+			dropped = append(dropped, queued.redemptionID)
+		}
+	}
+
+	if len(retry) > 0 {
+		p.mu.Lock()
+		p.queue[key] = append(p.queue[key], retry...)
+		p.mu.Unlock()
+	}
+
+	if len(dropped) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("kickredemption: could not %s redemptions %v: %w", key.decision, dropped, err)
+}
+
+// Run polls the broadcasters immediately and then every PollInterval, and flushes the queued decisions every
+// FlushInterval until ctx is done. The queued decisions are flushed a last time before it returns.
+func (p *Processor) Run(ctx context.Context) error {
+	flushTicker := time.NewTicker(p.config.FlushInterval)
+	defer flushTicker.Stop()
+	pollTicker := time.NewTicker(p.config.PollInterval)
+	defer pollTicker.Stop()
+
+	p.poll(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			if err := p.Flush(context.WithoutCancel(ctx)); err != nil {
+				p.logger.ErrorContext(ctx, "kick: flushing redemption decisions failed", slog.Any("error", err))
+			}
+			return ctx.Err()
+		case <-pollTicker.C:
+			p.poll(ctx)
+		case <-flushTicker.C:
+			if err := p.Flush(ctx); err != nil {
+				p.logger.ErrorContext(ctx, "kick: flushing redemption decisions failed", slog.Any("error", err))
+			}
+		}
+	}
+}
+
+func (p *Processor) poll(ctx context.Context) {
+	for _, broadcasterUserID := range p.config.Broadcasters {
+		if err := p.Poll(ctx, broadcasterUserID); err != nil {
+			p.logger.ErrorContext(ctx, "kick: polling redemptions failed",
+				slog.Int("broadcaster_user_id", broadcasterUserID),
+				slog.Any("error", err),
+			)
+		}
+	}
+}
@@ -0,0 +1,300 @@
+package kickredemption
+
+import (
+	"cmp"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/henrikah/kick-go-sdk/v2/enums/kickchannelrewardstatus"
+	"github.com/henrikah/kick-go-sdk/v2/kickcontracts"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/kickfilters"
+)
+
+// csvHeader is the header row of a CSV report.
+var csvHeader = []string{"redemption_id", "redeemed_at", "status", "reward_id", "reward_title", "reward_deleted", "cost", "redeemer_user_id", "user_input"}
+
+// statuses are the statuses a report pages through.
+var statuses = []kickchannelrewardstatus.ChannelRewardStatus{
+	kickchannelrewardstatus.Accepted,
+	kickchannelrewardstatus.Pending,
+	kickchannelrewardstatus.Rejected,
+}
+
+// Record is a redemption in a report.
+type Record struct {
+	ID         string                                      `json:"id"`
+	RedeemedAt time.Time                                   `json:"redeemed_at"`
+	Status     kickchannelrewardstatus.ChannelRewardStatus `json:"status"`
+	RewardID   string                                      `json:"reward_id"`
+
+	// RewardTitle is the title of the reward when the report was made.
+	RewardTitle string `json:"reward_title"`
+
+	// RewardDeleted is true when the reward was deleted after the redemption.
+	RewardDeleted bool `json:"reward_deleted,omitempty"`
+
+	// Cost is the cost of the reward when the report was made, zero when Kick did not report it.
+	Cost int `json:"cost,omitempty"`
+
+	RedeemerUserID int    `json:"redeemer_user_id"`
+	UserInput      string `json:"user_input,omitempty"`
+}
+
+// Counts counts redemptions by status.
+type Counts struct {
+	Total    int `json:"total"`
+	Accepted int `json:"accepted"`
+	Pending  int `json:"pending"`
+	Rejected int `json:"rejected"`
+
+	// Points is the cost of the accepted and pending redemptions, rejected redemptions are refunded.
+	Points int `json:"points"`
+}
+
+func (c *Counts) add(record Record) {
+	c.Total++
+	switch record.Status {
+	case kickchannelrewardstatus.Accepted:
+		c.Accepted++
+	case kickchannelrewardstatus.Pending:
+		c.Pending++
+	case kickchannelrewardstatus.Rejected:
+		c.Rejected++
+		return
+	}
+	c.Points += record.Cost
+}
+
+// RewardSummary aggregates the redemptions of a reward.
+type RewardSummary struct {
+	RewardID string `json:"reward_id"`
+	Title    string `json:"title"`
+	Deleted  bool   `json:"deleted,omitempty"`
+	Counts
+}
+
+// RedeemerSummary aggregates the redemptions of a user.
+type RedeemerSummary struct {
+	UserID int `json:"user_id"`
+	Counts
+}
+
+// Report is the redemptions of a channel over a time range, aggregated by reward, redeemer and status.
+type Report struct {
+	BroadcasterUserID int       `json:"broadcaster_user_id"`
+	Since             time.Time `json:"since"`
+	Until             time.Time `json:"until"`
+
+	// Totals aggregates every redemption.
+	Totals Counts `json:"totals"`
+
+	// ByReward is ordered by the number of redemptions, most redeemed first.
+	ByReward []RewardSummary `json:"by_reward"`
+
+	// ByRedeemer is ordered by the number of redemptions, most active user first.
+	ByRedeemer []RedeemerSummary `json:"by_redeemer"`
+
+	// Redemptions is ordered by RedeemedAt, oldest first.
+	Redemptions []Record `json:"redemptions"`
+}
+
+// ReporterConfig configures a Reporter.
+type ReporterConfig struct {
+	// ChannelReward is the service used to page through the redemptions.
+	ChannelReward kickcontracts.ChannelReward
+
+	// AccessToken returns the access token of the broadcaster used to read the redemptions of the channel.
+	AccessToken func(ctx context.Context, broadcasterUserID int) (string, error)
+}
+
+// Reporter builds redemption reports.
+type Reporter struct {
+	config ReporterConfig
+}
+
+// NewReporter creates a new Reporter with the provided configuration.
+func NewReporter(config ReporterConfig) (*Reporter, error) {
+	if err := kickerrors.ValidateNotNil("ChannelReward", config.ChannelReward); err != nil {
+		return nil, err
+	}
+	if err := kickerrors.ValidateNotNil("AccessToken", config.AccessToken); err != nil {
+		return nil, err
+	}
+
+	return &Reporter{config: config}, nil
+}
+
+// Report pages through the accepted, pending and rejected redemptions of the channel of the broadcaster and
+// aggregates those redeemed at or after since and before until. Rewards deleted since are included and flagged.
+func (r *Reporter) Report(ctx context.Context, broadcasterUserID int, since, until time.Time) (*Report, error) {
+	if err := kickerrors.ValidateBroadcasterUserID(broadcasterUserID); err != nil {
+		return nil, err
+	}
+	if !until.After(since) {
+		return nil, &kickerrors.ValidationError{Field: "until", Message: "must be after since"}
+	}
+
+	accessToken, err := r.config.AccessToken(ctx, broadcasterUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &Report{BroadcasterUserID: broadcasterUserID, Since: since, Until: until, Redemptions: []Record{}}
+	seen := map[string]bool{}
+
+	for _, status := range statuses {
+		cursor := ""
+		for {
+			filters := kickfilters.NewRewardRedemptionsFilter().WithStatus(status)
+			if cursor != "" {
+				filters.WithCursor(cursor)
+			}
+
+			page, err := r.config.ChannelReward.GetChannelRewardRedemptions(ctx, accessToken, filters)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, reward := range page.Data {
+				deleted := reward.Reward.IsDeleted != nil && *reward.Reward.IsDeleted
+				cost := 0
+				if reward.Reward.Cost != nil {
+					cost = int(*reward.Reward.Cost)
+				}
+
+				for _, redemption := range reward.Redemptions {
+					redeemedAt := redemption.RedeemedAt.Time()
+					if seen[redemption.ID] || redeemedAt.Before(since) || !redeemedAt.Before(until) {
+						continue
+					}
+					seen[redemption.ID] = true
+
+					report.Redemptions = append(report.Redemptions, Record{
+						ID:             redemption.ID,
+						RedeemedAt:     redeemedAt,
+						Status:         cmp.Or(redemption.Status, status),
+						RewardID:       reward.Reward.ID,
+						RewardTitle:    reward.Reward.Title,
+						RewardDeleted:  deleted,
+						Cost:           cost,
+						RedeemerUserID: int(redemption.Redeemer.BroadcasterUserID),
+						UserInput:      redemption.UserInput,
+					})
+				}
+			}
+
+			if page.Pagination.NextCursor == "" || page.Pagination.NextCursor == cursor {
+				break
+			}
+			cursor = page.Pagination.NextCursor
+		}
+	}
+
+	report.aggregate()
+	return report, nil
+}
+
+func (r *Report) aggregate() {
+	slices.SortStableFunc(r.Redemptions, func(a, b Record) int {
+		return a.RedeemedAt.Compare(b.RedeemedAt)
+	})
+
+	r.Totals = Counts{}
+	rewards := map[string]*RewardSummary{}
+	redeemers := map[int]*RedeemerSummary{}
+	r.ByReward, r.ByRedeemer = []RewardSummary{}, []RedeemerSummary{}
+
+	for _, record := range r.Redemptions {
+		r.Totals.add(record)
+
+		reward, ok := rewards[record.RewardID]
+		if !ok {
+			reward = &RewardSummary{RewardID: record.RewardID, Title: record.RewardTitle, Deleted: record.RewardDeleted}
+			rewards[record.RewardID] = reward
+		}
+		reward.add(record)
+
+		redeemer, ok := redeemers[record.RedeemerUserID]
+		if !ok {
+			redeemer = &RedeemerSummary{UserID: record.RedeemerUserID}
+			redeemers[record.RedeemerUserID] = redeemer
+		}
+		redeemer.add(record)
+	}
+
+	for _, reward := range rewards {
+		r.ByReward = append(r.ByReward, *reward)
+	}
+	slices.SortFunc(r.ByReward, func(a, b RewardSummary) int {
+		return cmp.Or(cmp.Compare(b.Total, a.Total), cmp.Compare(a.Title, b.Title), cmp.Compare(a.RewardID, b.RewardID))
+	})
+
+	for _, redeemer := range redeemers {
+		r.ByRedeemer = append(r.ByRedeemer, *redeemer)
+	}
+	slices.SortFunc(r.ByRedeemer, func(a, b RedeemerSummary) int {
+		return cmp.Or(cmp.Compare(b.Total, a.Total), cmp.Compare(a.UserID, b.UserID))
+	})
+}
+
+// ByStatus returns the redemptions with the status.
+func (r *Report) ByStatus(status kickchannelrewardstatus.ChannelRewardStatus) []Record {
+	var records []Record
+	for _, record := range r.Redemptions {
+		if record.Status == status {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+// WriteJSON writes the report as indented JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
+
+// WriteCSV writes the redemptions of the report as CSV with a header row, one row per redemption. Times are
+// RFC 3339 in UTC. Titles and user input that a spreadsheet would run as a formula are prefixed with a quote.
+func (r *Report) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, record := range r.Redemptions {
+		row := []string{
+			record.ID,
+			record.RedeemedAt.UTC().Format(time.RFC3339),
+			string(record.Status),
+			record.RewardID,
+			spreadsheetSafe(record.RewardTitle),
+			strconv.FormatBool(record.RewardDeleted),
+			strconv.Itoa(record.Cost),
+			strconv.Itoa(record.RedeemerUserID),
+			spreadsheetSafe(record.UserInput),
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// spreadsheetSafe prefixes values starting like a formula with a quote, so opening a report does not run user input.
+func spreadsheetSafe(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
@@ -0,0 +1,148 @@
+package kickrewardsync
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+)
+
+const (
+	maxTitleCharacters       = 50
+	maxDescriptionCharacters = 200
+)
+
+// backgroundColor is the hex color format Kick accepts for BackgroundColor.
+var backgroundColor = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// Reward is the desired state of a channel reward.
+//
+// The struct tags support JSON and YAML, so a catalog can be decoded with any YAML library as well.
+type Reward struct {
+	// Key identifies the reward across syncs, so the title can change without recreating the reward.
+	// Defaults to the title, ignoring case, when empty.
+	Key string `json:"key,omitempty" yaml:"key,omitempty"`
+
+	// Title is 1 to 50 characters.
+	Title string `json:"title" yaml:"title"`
+
+	// Description is at most 200 characters.
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+
+	// Cost is 1 or higher.
+	Cost int `json:"cost" yaml:"cost"`
+
+	// BackgroundColor is a hex color like "#00E701". Kick picks the color when empty.
+	BackgroundColor string `json:"background_color,omitempty" yaml:"background_color,omitempty"`
+
+	// IsEnabled defaults to true when nil.
+	IsEnabled *bool `json:"is_enabled,omitempty" yaml:"is_enabled,omitempty"`
+
+	IsPaused                          bool `json:"is_paused,omitempty" yaml:"is_paused,omitempty"`
+	IsUserInputRequired               bool `json:"is_user_input_required,omitempty" yaml:"is_user_input_required,omitempty"`
+	ShouldRedemptionsSkipRequestQueue bool `json:"should_redemptions_skip_request_queue,omitempty" yaml:"should_redemptions_skip_request_queue,omitempty"`
+}
+
+// key returns the stable key of the reward.
+func (r Reward) key() string {
+	if r.Key != "" {
+		return r.Key
+	}
+	return strings.ToLower(r.Title)
+}
+
+func (r Reward) enabled() bool {
+	return r.IsEnabled == nil || *r.IsEnabled
+}
+
+// Channel lists the desired rewards of the channel of a broadcaster.
+type Channel struct {
+	BroadcasterUserID int      `json:"broadcaster_user_id" yaml:"broadcaster_user_id"`
+	Rewards           []Reward `json:"rewards" yaml:"rewards"`
+}
+
+// Catalog is the desired rewards of every channel.
+type Catalog struct {
+	Channels []Channel `json:"channels" yaml:"channels"`
+}
+
+// ReadJSON reads and validates a catalog.
+func ReadJSON(r io.Reader) (*Catalog, error) {
+	var catalog Catalog
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&catalog); err != nil {
+		return nil, err
+	}
+	return &catalog, catalog.Validate()
+}
+
+// Validate checks every reward against the limits of Kick and returns a *kickerrors.ValidationError for the first
+// reward that breaks one, its Field names the reward, e.g. "Channels[0].Rewards[2].Title". Channels must be unique
+// within the catalog, keys and titles within the channel.
+func (c Catalog) Validate() error {
+	channels := map[int]bool{}
+	for i, channel := range c.Channels {
+		if err := kickerrors.ValidateBroadcasterUserID(channel.BroadcasterUserID); err != nil {
+			return err
+		}
+		if channels[channel.BroadcasterUserID] {
+			return &kickerrors.ValidationError{
+				Field:   fmt.Sprintf("Channels[%d].BroadcasterUserID", i),
+				Message: fmt.Sprintf("channel %d is listed more than once", channel.BroadcasterUserID),
+			}
+		}
+		channels[channel.BroadcasterUserID] = true
+
+		keys, titles := map[string]bool{}, map[string]bool{}
+		for j, reward := range channel.Rewards {
+			if err := reward.validate(); err != nil {
+				if validationErr := kickerrors.IsValidationError(err); validationErr != nil {
+					validationErr.Field = fmt.Sprintf("Channels[%d].Rewards[%d].%s", i, j, validationErr.Field)
+				}
+				return err
+			}
+
+			key, title := reward.key(), strings.ToLower(reward.Title)
+			if keys[key] {
+				return &kickerrors.ValidationError{
+					Field:   fmt.Sprintf("Channels[%d].Rewards[%d].Key", i, j),
+					Message: fmt.Sprintf("%q is used more than once in channel %d", key, channel.BroadcasterUserID),
+				}
+			}
+			if titles[title] {
+				return &kickerrors.ValidationError{
+					Field:   fmt.Sprintf("Channels[%d].Rewards[%d].Title", i, j),
+					Message: fmt.Sprintf("%q is used more than once in channel %d", reward.Title, channel.BroadcasterUserID),
+				}
+			}
+			keys[key], titles[title] = true, true
+		}
+	}
+	return nil
+}
+
+func (r Reward) validate() error {
+	if err := kickerrors.ValidateNotEmpty("Title", strings.TrimSpace(r.Title)); err != nil {
+		return err
+	}
+	if err := kickerrors.ValidateMaxCharacters("Title", r.Title, maxTitleCharacters); err != nil {
+		return err
+	}
+	if err := kickerrors.ValidateMaxCharacters("Description", r.Description, maxDescriptionCharacters); err != nil {
+		return err
+	}
+	if err := kickerrors.ValidateMinValue("Cost", r.Cost, 1); err != nil {
+		return err
+	}
+	if r.BackgroundColor != "" && !backgroundColor.MatchString(r.BackgroundColor) {
+		return &kickerrors.ValidationError{
+			Field:   "BackgroundColor",
+			Message: fmt.Sprintf("must be a hex color like #00E701, got %q", r.BackgroundColor),
+		}
+	}
+	return nil
+}
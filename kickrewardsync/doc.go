@@ -0,0 +1,39 @@
+// Package kickrewardsync keeps the channel rewards of many channels in line with a declarative catalog.
+//
+// A Catalog lists the desired rewards per channel and is validated against the limits of Kick before anything is
+// changed. Its struct tags support JSON and YAML; ReadJSON reads JSON and any YAML library decodes YAML into it.
+// A Syncer plans the creates, updates and deletes that bring each channel in line with the catalog and applies
+// them, or only returns the plan with DryRun. Rewards are matched by key or title, and only rewards the syncer
+// created or matched before, as remembered by its Store, are ever deleted:
+//
+//	file, err := os.Open("rewards.json")
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	defer file.Close()
+//
+//	catalog, err := kickrewardsync.ReadJSON(file)
+//	if err != nil {
+//		log.Fatalf("could not read catalog: %v", err)
+//	}
+//
+//	syncer, err := kickrewardsync.NewSyncer(kickrewardsync.SyncerConfig{
+//		ChannelReward: apiClient.ChannelReward(),
+//		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+//			return tokens[broadcasterUserID], nil
+//		},
+//		Store:  kickrewardsync.NewFileStore("rewards-state.json"),
+//		DryRun: true,
+//	})
+//	if err != nil {
+//		log.Fatalf("could not create syncer: %v", err)
+//	}
+//
+//	plan, err := syncer.Sync(ctx, *catalog)
+//	if err != nil {
+//		log.Fatalf("could not sync rewards: %v", err)
+//	}
+//	for _, change := range plan.Changes {
+//		log.Println(change)
+//	}
+package kickrewardsync
@@ -0,0 +1,122 @@
+package kickrewardsync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"strconv"
+	"sync"
+)
+
+// Store remembers which rewards the syncer manages, as a map from the key of a reward to its ID per channel.
+// Only rewards in the store are ever deleted. Implementations must be safe for concurrent use.
+type Store interface {
+	// Load returns the managed rewards of the channel of the broadcaster.
+	Load(ctx context.Context, broadcasterUserID int) (map[string]string, error)
+
+	// Save replaces the managed rewards of the channel of the broadcaster.
+	Save(ctx context.Context, broadcasterUserID int, rewardIDs map[string]string) error
+}
+
+// MemoryStore is a Store that keeps the managed rewards in memory.
+type MemoryStore struct {
+	mu       sync.RWMutex
+	channels map[int]map[string]string
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		channels: make(map[int]map[string]string),
+	}
+}
+
+func (s *MemoryStore) Load(_ context.Context, broadcasterUserID int) (map[string]string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return maps.Clone(s.channels[broadcasterUserID]), nil
+}
+
+func (s *MemoryStore) Save(_ context.Context, broadcasterUserID int, rewardIDs map[string]string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(rewardIDs) == 0 {
+		delete(s.channels, broadcasterUserID)
+		return nil
+	}
+	s.channels[broadcasterUserID] = maps.Clone(rewardIDs)
+	return nil
+}
+
+// FileStore is a Store that keeps the managed rewards of every channel in a JSON file, so they survive restarts.
+type FileStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+var _ Store = (*FileStore)(nil)
+
+// NewFileStore creates a FileStore for the JSON file at path. The file is created on the first Save.
+func NewFileStore(path string) *FileStore {
+	return &FileStore{path: path}
+}
+
+func (s *FileStore) Load(_ context.Context, broadcasterUserID int) (map[string]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	channels, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	return channels[strconv.Itoa(broadcasterUserID)], nil
+}
+
+func (s *FileStore) Save(_ context.Context, broadcasterUserID int, rewardIDs map[string]string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	channels, err := s.read()
+	if err != nil {
+		return err
+	}
+
+	if len(rewardIDs) == 0 {
+		delete(channels, strconv.Itoa(broadcasterUserID))
+	} else {
+		channels[strconv.Itoa(broadcasterUserID)] = rewardIDs
+	}
+
+	data, err := json.MarshalIndent(channels, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	temporary := s.path + ".tmp"
+	if err := os.WriteFile(temporary, data, 0o600); err != nil {
+		return fmt.Errorf("could not write reward state: %w", err)
+	}
+	return os.Rename(temporary, s.path)
+}
+
+func (s *FileStore) read() (map[string]map[string]string, error) {
+	channels := map[string]map[string]string{}
+
+	data, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return channels, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read reward state: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &channels); err != nil {
+		return nil, fmt.Errorf("could not decode reward state '%s': %w", s.path, err)
+	}
+	return channels, nil
+}
@@ -0,0 +1,362 @@
+package kickrewardsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"strings"
+
+	"github.com/henrikah/kick-go-sdk/v2/internal/logging"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickcontracts"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+)
+
+// ChangeType is the kind of a planned change.
+type ChangeType string
+
+const (
+	// ChangeCreate creates a reward that is in the catalog but not in the channel.
+	ChangeCreate ChangeType = "create"
+	// ChangeUpdate updates a reward that differs from the catalog.
+	ChangeUpdate ChangeType = "update"
+	// ChangeDelete deletes a managed reward that was removed from the catalog.
+	ChangeDelete ChangeType = "delete"
+)
+
+// Change is a planned change to a reward of a channel.
+type Change struct {
+	Type              ChangeType
+	BroadcasterUserID int
+	Key               string
+
+	// RewardID is empty for created rewards until the change is applied.
+	RewardID string
+
+	Title string
+
+	// Fields lists the JSON names of the fields an update changes.
+	Fields []string
+
+	// Err is the error of the change after Apply, nil when it succeeded or was not applied.
+	Err error
+
+	desired Reward
+	update  kickapitypes.UpdateChannelReward
+}
+
+// String describes the change, e.g. `update "Hydrate" in channel 12345 (cost, is_paused)`.
+func (c Change) String() string {
+	description := fmt.Sprintf("%s %q in channel %d", c.Type, c.Title, c.BroadcasterUserID)
+	if len(c.Fields) > 0 {
+		description += " (" + strings.Join(c.Fields, ", ") + ")"
+	}
+	return description
+}
+
+// Plan is the changes that bring the channels in line with a catalog.
+type Plan struct {
+	Changes []Change
+
+	// Unchanged is the number of managed rewards that already match the catalog.
+	Unchanged int
+
+	// Unmanaged is the number of rewards in the channels that are not in the catalog and were never managed.
+	// They are left alone.
+	Unmanaged int
+
+	channels []channelPlan
+}
+
+type channelPlan struct {
+	broadcasterUserID int
+
+	// managed maps the keys of the matched rewards to their IDs, created rewards are added by Apply.
+	managed map[string]string
+}
+
+// SyncerConfig configures a Syncer.
+type SyncerConfig struct {
+	// ChannelReward is the service used to read and change the rewards.
+	ChannelReward kickcontracts.ChannelReward
+
+	// AccessToken returns the access token of the broadcaster used to manage the rewards of the channel.
+	AccessToken func(ctx context.Context, broadcasterUserID int) (string, error)
+
+	// Store remembers the managed rewards. Defaults to a MemoryStore when nil, with which rewards removed from the
+	// catalog are only deleted while the process runs.
+	Store Store
+
+	// DryRun makes Sync return the plan without applying it.
+	DryRun bool
+
+	// Logger receives every applied change. Defaults to slog.Default() when nil.
+	Logger *slog.Logger
+}
+
+// Syncer keeps the rewards of channels in line with a catalog.
+type Syncer struct {
+	config SyncerConfig
+	logger *slog.Logger
+}
+
+// NewSyncer creates a new Syncer with the provided configuration.
+func NewSyncer(config SyncerConfig) (*Syncer, error) {
+	if err := kickerrors.ValidateNotNil("ChannelReward", config.ChannelReward); err != nil {
+		return nil, err
+	}
+	if err := kickerrors.ValidateNotNil("AccessToken", config.AccessToken); err != nil {
+		return nil, err
+	}
+	if config.Store == nil {
+		config.Store = NewMemoryStore()
+	}
+
+	return &Syncer{
+		config: config,
+		logger: logging.OrDefault(config.Logger),
+	}, nil
+}
+
+// Sync plans the catalog and applies the plan, unless DryRun is set. The plan is returned in both cases.
+func (s *Syncer) Sync(ctx context.Context, catalog Catalog) (*Plan, error) {
+	plan, err := s.Plan(ctx, catalog)
+	if err != nil || s.config.DryRun {
+		return plan, err
+	}
+	return plan, s.Apply(ctx, plan)
+}
+
+// Plan validates the catalog and compares it with the rewards of every channel in it.
+//
+// A reward of the catalog matches the reward its key was last synced to, or else a reward with the same title,
+// ignoring case. Matched rewards that differ are updated and missing rewards are created. Managed rewards whose key
+// was removed from the catalog are deleted. Rewards that were never managed are not changed.
+func (s *Syncer) Plan(ctx context.Context, catalog Catalog) (*Plan, error) {
+	if err := catalog.Validate(); err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{}
+	for _, channel := range catalog.Channels {
+		if err := s.planChannel(ctx, plan, channel); err != nil {
+			return nil, err
+		}
+	}
+	return plan, nil
+}
+
+func (s *Syncer) planChannel(ctx context.Context, plan *Plan, channel Channel) error {
+	broadcasterUserID := channel.BroadcasterUserID
+
+	accessToken, err := s.config.AccessToken(ctx, broadcasterUserID)
+	if err != nil {
+		return err
+	}
+	actual, err := s.config.ChannelReward.GetChannelRewards(ctx, accessToken)
+	if err != nil {
+		return err
+	}
+	stored, err := s.config.Store.Load(ctx, broadcasterUserID)
+	if err != nil {
+		return err
+	}
+
+	byID := make(map[string]kickapitypes.ChannelRewardData, len(actual.Data))
+	for _, reward := range actual.Data {
+		byID[reward.ID] = reward
+	}
+
+	matched := map[string]bool{}
+	managed := map[string]string{}
+	var creates, updates, deletes []Change
+
+	// Match by stored key first, so a renamed reward is updated rather than recreated.
+	pending := make([]Reward, 0, len(channel.Rewards))
+	for _, desired := range channel.Rewards {
+		if id, ok := stored[desired.key()]; ok {
+			if _, exists := byID[id]; exists && !matched[id] {
+				matched[id], managed[desired.key()] = true, id
+				continue
+			}
+		}
+		pending = append(pending, desired)
+	}
+
+	for _, desired := range pending {
+		for _, reward := range actual.Data {
+			if !matched[reward.ID] && strings.EqualFold(reward.Title, desired.Title) {
+				matched[reward.ID], managed[desired.key()] = true, reward.ID
+				break
+			}
+		}
+	}
+
+	for _, desired := range channel.Rewards {
+		change := Change{BroadcasterUserID: broadcasterUserID, Key: desired.key(), Title: desired.Title, desired: desired}
+
+		id, ok := managed[desired.key()]
+		if !ok {
+			change.Type = ChangeCreate
+			creates = append(creates, change)
+			continue
+		}
+
+		change.update, change.Fields = diff(desired, byID[id])
+		if len(change.Fields) == 0 {
+			plan.Unchanged++
+			continue
+		}
+		change.Type, change.RewardID = ChangeUpdate, id
+		updates = append(updates, change)
+	}
+
+	for key, id := range stored {
+		reward, exists := byID[id]
+		if _, desired := managed[key]; desired || !exists || matched[id] {
+			continue
+		}
+		matched[id] = true
+		deletes = append(deletes, Change{Type: ChangeDelete, BroadcasterUserID: broadcasterUserID, Key: key, RewardID: id, Title: reward.Title})
+	}
+
+	plan.Unmanaged += len(actual.Data) - len(matched)
+
+	// Deletes go first to free titles for updates and creates.
+	plan.Changes = append(plan.Changes, deletes...)
+	plan.Changes = append(plan.Changes, updates...)
+	plan.Changes = append(plan.Changes, creates...)
+	plan.channels = append(plan.channels, channelPlan{broadcasterUserID: broadcasterUserID, managed: managed})
+	return nil
+}
+
+// Apply applies the changes of the plan in order and records the managed rewards in the Store. A failed change does
+// not stop the others, its error is set on the change and all errors are returned joined.
+func (s *Syncer) Apply(ctx context.Context, plan *Plan) error {
+	if err := kickerrors.ValidateNotNil("plan", plan); err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, channel := range plan.channels {
+		managed := maps.Clone(channel.managed)
+
+		accessToken, err := s.config.AccessToken(ctx, channel.broadcasterUserID)
+		for i := range plan.Changes {
+			change := &plan.Changes[i]
+			if change.BroadcasterUserID != channel.broadcasterUserID {
+				continue
+			}
+
+			if err != nil {
+				change.Err = err
+			} else {
+				change.Err = s.apply(ctx, accessToken, change)
+			}
+
+			switch {
+			case change.Err != nil:
+				errs = append(errs, fmt.Errorf("kickrewardsync: %s: %w", change, change.Err))
+				if change.Type == ChangeDelete {
+					managed[change.Key] = change.RewardID
+				}
+			case change.Type == ChangeCreate:
+				managed[change.Key] = change.RewardID
+			}
+		}
+
+		if err := s.config.Store.Save(ctx, channel.broadcasterUserID, managed); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *Syncer) apply(ctx context.Context, accessToken string, change *Change) error {
+	switch change.Type {
+	case ChangeDelete:
+		if err := s.config.ChannelReward.DeleteChannelReward(ctx, accessToken, change.RewardID); err != nil {
+			return err
+		}
+	case ChangeUpdate:
+		if _, err := s.config.ChannelReward.UpdateChannelReward(ctx, accessToken, change.RewardID, change.update); err != nil {
+			return err
+		}
+	case ChangeCreate:
+		created, err := s.config.ChannelReward.CreateChannelReward(ctx, accessToken, create(change.desired))
+		if err != nil {
+			return err
+		}
+		change.RewardID = created.Data.ID
+
+		// Rewards cannot be created paused.
+		if change.desired.IsPaused {
+			paused := true
+			if _, err := s.config.ChannelReward.UpdateChannelReward(ctx, accessToken, change.RewardID, kickapitypes.UpdateChannelReward{IsPaused: &paused}); err != nil {
+				return err
+			}
+		}
+	}
+
+	s.logger.InfoContext(ctx, "kick: reward synced",
+		slog.String("change", string(change.Type)),
+		slog.Int("broadcaster_user_id", change.BroadcasterUserID),
+		slog.String("reward_id", change.RewardID),
+		slog.String("title", change.Title),
+	)
+	return nil
+}
+
+func create(desired Reward) kickapitypes.CreateChannelReward {
+	enabled := desired.enabled()
+	reward := kickapitypes.CreateChannelReward{
+		Cost:                              desired.Cost,
+		IsEnabled:                         &enabled,
+		IsUserInputRequired:               &desired.IsUserInputRequired,
+		ShouldRedemptionsSkipRequestQueue: &desired.ShouldRedemptionsSkipRequestQueue,
+		Title:                             desired.Title,
+	}
+	if desired.Description != "" {
+		reward.Description = &desired.Description
+	}
+	if desired.BackgroundColor != "" {
+		reward.BackgroundColor = &desired.BackgroundColor
+	}
+	return reward
+}
+
+// diff returns the update that brings the actual reward in line with the desired one and the fields it changes.
+func diff(desired Reward, actual kickapitypes.ChannelRewardData) (kickapitypes.UpdateChannelReward, []string) {
+	var update kickapitypes.UpdateChannelReward
+	var fields []string
+
+	if desired.Title != actual.Title {
+		update.Title, fields = &desired.Title, append(fields, "title")
+	}
+	if desired.Description != actual.Description {
+		update.Description, fields = &desired.Description, append(fields, "description")
+	}
+	if desired.Cost != actual.Cost {
+		update.Cost, fields = &desired.Cost, append(fields, "cost")
+	}
+	if desired.BackgroundColor != "" && !strings.EqualFold(desired.BackgroundColor, actual.BackgroundColor) {
+		update.BackgroundColor, fields = &desired.BackgroundColor, append(fields, "background_color")
+	}
+	if enabled := desired.enabled(); enabled != actual.IsEnabled {
+		update.IsEnabled, fields = &enabled, append(fields, "is_enabled")
+	}
+	if desired.IsPaused != actual.IsPaused {
+		update.IsPaused, fields = &desired.IsPaused, append(fields, "is_paused")
+	}
+	if desired.IsUserInputRequired != actual.IsUserInputRequired {
+		update.IsUserInputRequired, fields = &desired.IsUserInputRequired, append(fields, "is_user_input_required")
+	}
+	if desired.ShouldRedemptionsSkipRequestQueue != actual.ShouldRedemptionsSkipRequestQueue {
+		update.ShouldRedemptionsSkipRequestQueue, fields = &desired.ShouldRedemptionsSkipRequestQueue, append(fields, "should_redemptions_skip_request_queue")
+	}
+
+	return update, fields
+}
@@ -23,6 +23,8 @@ func newKicksService(client *apiClient) kickcontracts.Kicks {
 }
 
 func (c *kicksClient) GetKicksLeaderboard(ctx context.Context, accessToken string, limit *int) (*kickapitypes.Kicks, error) {
+	ctx = c.client.requester.WithOperation(ctx, "Kicks.GetKicksLeaderboard")
+
 	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
 		return nil, err
 	}
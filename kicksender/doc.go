@@ -0,0 +1,32 @@
+// Package kicksender sends outbound chat messages through a paced per-channel queue.
+//
+// A Sender keeps a queue per channel and sends at most MessagesPerInterval messages per Interval to each channel.
+// Messages with a higher priority, such as moderation notices, are sent before the rest of the queue, a message
+// identical to the previous message of the channel is dropped, and long messages are split at word boundaries
+// into numbered parts:
+//
+//	sender, err := kicksender.NewSender(kicksender.SenderConfig{
+//		Chat: apiClient.Chat(),
+//		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+//			return botAccessToken, nil
+//		},
+//		MessagesPerInterval: 2,
+//		Interval:            time.Second,
+//	})
+//	if err != nil {
+//		log.Fatalf("could not create sender: %v", err)
+//	}
+//	defer sender.Close()
+//
+//	deliveries, err := sender.Send(ctx, kicksender.Message{
+//		BroadcasterUserID: 12345,
+//		Content:           "Chat rules: be kind, no spam.",
+//		Priority:          kicksender.PriorityHigh,
+//	})
+//	if err != nil {
+//		log.Printf("could not send message: %v", err)
+//	}
+//	for _, delivery := range deliveries {
+//		log.Printf("sent part %d/%d as %s", delivery.Part, delivery.Parts, delivery.MessageID)
+//	}
+package kicksender
@@ -0,0 +1,411 @@
+package kicksender
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/henrikah/kick-go-sdk/v2/internal/logging"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickcontracts"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+)
+
+const (
+	defaultMessagesPerInterval = 1
+	defaultInterval            = time.Second
+	defaultMaxLength           = kickMaxLength
+	kickMaxLength              = 500
+	defaultDedupWindow         = 30 * time.Second
+)
+
+// ErrClosed is returned for messages enqueued on, or still queued in, a closed Sender.
+var ErrClosed = errors.New("kicksender: sender is closed")
+
+// Priority decides the order in which queued messages of a channel are sent. Higher priorities are sent first,
+// messages with the same priority are sent in the order they were enqueued.
+type Priority int
+
+const (
+	// PriorityLow is for messages that can wait, e.g. periodic announcements.
+	PriorityLow Priority = iota - 1
+	// PriorityNormal is the default priority.
+	PriorityNormal
+	// PriorityHigh is for messages that must go out first, e.g. moderation notices.
+	PriorityHigh
+)
+
+const lanes = int(PriorityHigh-PriorityLow) + 1
+
+// Message is a chat message to send.
+type Message struct {
+	// BroadcasterUserID is the channel the message is sent to. Messages are queued and paced per channel,
+	// and the ID is passed to SenderConfig.AccessToken. Bot messages go to the channel of the bot token.
+	BroadcasterUserID int
+
+	// Content is the text of the message. It cannot be empty or only whitespace. Content longer than
+	// SenderConfig.MaxLength is split into numbered parts.
+	Content string
+
+	// ReplyToMessageID makes every part of the message a reply to the message with the ID.
+	ReplyToMessageID *string
+
+	// AsUser sends the message with SendChatMessageAsUser instead of SendChatMessageAsBot.
+	AsUser bool
+
+	// Priority of the message. Defaults to PriorityNormal.
+	Priority Priority
+}
+
+// Delivery describes a sent part of a message.
+type Delivery struct {
+	// Part is the 1-based number of the part.
+	Part int
+	// Parts is the total number of parts the message was split into.
+	Parts int
+	// Content is the text that was sent, including the part number.
+	Content string
+	// MessageID is the ID Kick assigned to the sent part.
+	MessageID string
+	// Sent reports whether Kick accepted the part.
+	Sent bool
+}
+
+// Receipt tracks the delivery of an enqueued message.
+type Receipt struct {
+	message    Message
+	parts      []string
+	duplicate  bool
+	done       chan struct{}
+	deliveries []Delivery
+	err        error
+}
+
+// Wait blocks until every part of the message was sent, sending failed or ctx is done. It returns the delivered
+// parts and the error of the part that failed. Cancelling ctx stops waiting but does not dequeue the message.
+func (r *Receipt) Wait(ctx context.Context) ([]Delivery, error) {
+	select {
+	case <-r.done:
+		return r.deliveries, r.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Duplicate reports whether the message was dropped because it repeated the previous message of the channel.
+func (r *Receipt) Duplicate() bool {
+	return r.duplicate
+}
+
+func (r *Receipt) finish(err error) {
+	r.err = err
+	close(r.done)
+}
+
+// SenderConfig configures a Sender.
+type SenderConfig struct {
+	// Chat is the service used to send the messages.
+	Chat kickcontracts.Chat
+
+	// AccessToken returns the access token used to send messages to the channel of the broadcaster.
+	AccessToken func(ctx context.Context, broadcasterUserID int) (string, error)
+
+	// MessagesPerInterval is the number of messages sent to a channel per Interval. Defaults to 1 when zero.
+	MessagesPerInterval int
+
+	// Interval is the pacing window of MessagesPerInterval. Defaults to 1 second when zero.
+	Interval time.Duration
+
+	// MaxLength is the maximum number of characters of a message before it is split. Must be between 10 and 500,
+	// the most Kick accepts. Defaults to 500 when zero.
+	MaxLength int
+
+	// DedupWindow drops a message identical to the previous message of the channel enqueued within the window.
+	// Messages are identical when their content, reply target and AsUser match.
+	// Defaults to 30 seconds when zero, a negative window disables dedup.
+	DedupWindow time.Duration
+
+	// Logger receives failed deliveries. Defaults to slog.Default() when nil.
+	Logger *slog.Logger
+}
+
+type channel struct {
+	lanes   [lanes][]*Receipt
+	running bool
+	sent    []time.Time
+	last    dedupKey
+	lastAt  time.Time
+}
+
+// dedupKey holds the fields that make two messages of a channel identical.
+type dedupKey struct {
+	content string
+	replyTo string
+	asUser  bool
+}
+
+func keyOf(message Message) dedupKey {
+	key := dedupKey{content: message.Content, asUser: message.AsUser}
+	if message.ReplyToMessageID != nil {
+		key.replyTo = *message.ReplyToMessageID
+	}
+	return key
+}
+
+// Sender queues outbound chat messages per channel and paces them. It is safe for concurrent use.
+type Sender struct {
+	config   SenderConfig
+	logger   *slog.Logger
+	ctx      context.Context
+	cancel   context.CancelFunc
+	mu       sync.Mutex
+	channels map[int]*channel
+	workers  sync.WaitGroup
+}
+
+// NewSender creates a new Sender with the provided configuration.
+func NewSender(config SenderConfig) (*Sender, error) {
+	if err := kickerrors.ValidateNotNil("Chat", config.Chat); err != nil {
+		return nil, err
+	}
+	if err := kickerrors.ValidateNotNil("AccessToken", config.AccessToken); err != nil {
+		return nil, err
+	}
+
+	config.MessagesPerInterval = cmp.Or(config.MessagesPerInterval, defaultMessagesPerInterval)
+	config.Interval = cmp.Or(config.Interval, defaultInterval)
+	config.MaxLength = cmp.Or(config.MaxLength, defaultMaxLength)
+	config.DedupWindow = cmp.Or(config.DedupWindow, defaultDedupWindow)
+
+	if err := kickerrors.ValidateMinValue("MessagesPerInterval", config.MessagesPerInterval, 1); err != nil {
+		return nil, err
+	}
+	if err := kickerrors.ValidateBetween("MaxLength", config.MaxLength, 10, kickMaxLength); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &Sender{
+		config:   config,
+		logger:   logging.OrDefault(config.Logger),
+		ctx:      ctx,
+		cancel:   cancel,
+		channels: make(map[int]*channel),
+	}, nil
+}
+
+// Enqueue queues the message and returns a Receipt to wait for its delivery. A message identical to the previous
+// message of the channel within the dedup window is not queued, its Receipt is done and reports Duplicate.
+// ErrTooManyParts is returned when the message cannot be split to fit MaxLength.
+func (s *Sender) Enqueue(message Message) (*Receipt, error) {
+	if err := kickerrors.ValidateChatMessage(strings.TrimSpace(message.Content)); err != nil {
+		return nil, err
+	}
+	if message.AsUser {
+		if err := kickerrors.ValidateBroadcasterUserID(message.BroadcasterUserID); err != nil {
+			return nil, err
+		}
+	}
+	if err := kickerrors.ValidateBetween("Priority", int(message.Priority), int(PriorityLow), int(PriorityHigh)); err != nil {
+		return nil, err
+	}
+
+	parts, err := Split(message.Content, s.config.MaxLength)
+	if err != nil {
+		return nil, err
+	}
+
+	receipt := &Receipt{
+		message: message,
+		parts:   parts,
+		done:    make(chan struct{}),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.ctx.Err() != nil {
+		return nil, ErrClosed
+	}
+
+	now := time.Now()
+	s.prune(now)
+
+	ch, ok := s.channels[message.BroadcasterUserID]
+	if !ok {
+		ch = &channel{}
+		s.channels[message.BroadcasterUserID] = ch
+	}
+
+	key := keyOf(message)
+	if s.config.DedupWindow > 0 && ch.last == key && now.Sub(ch.lastAt) < s.config.DedupWindow {
+		receipt.duplicate = true
+		receipt.finish(nil)
+		return receipt, nil
+	}
+	ch.last = key
+	ch.lastAt = now
+
+	lane := message.Priority - PriorityLow
+	ch.lanes[lane] = append(ch.lanes[lane], receipt)
+
+	if !ch.running {
+		ch.running = true
+		s.workers.Add(1)
+		go s.work(message.BroadcasterUserID, ch)
+	}
+
+	return receipt, nil
+}
+
+// Send enqueues the message and waits for its delivery, see Enqueue and Receipt.Wait.
+func (s *Sender) Send(ctx context.Context, message Message) ([]Delivery, error) {
+	receipt, err := s.Enqueue(message)
+	if err != nil {
+		return nil, err
+	}
+	return receipt.Wait(ctx)
+}
+
+// Close stops sending, fails every queued message with ErrClosed and waits for the in-flight sends to finish.
+// The remaining parts of a split message that is being sent are failed with ErrClosed as well.
+func (s *Sender) Close() {
+	s.cancel()
+	s.workers.Wait()
+}
+
+// work sends the queued messages of a channel until its queue is empty. The next message is picked after waiting
+// for the pacing, so messages with a higher priority enqueued in the meantime go first.
+func (s *Sender) work(broadcasterUserID int, ch *channel) {
+	defer s.workers.Done()
+
+	for {
+		err := s.wait(ch)
+
+		s.mu.Lock()
+		receipt := ch.next()
+		if receipt == nil || err != nil {
+			ch.running = false
+			s.mu.Unlock()
+			if receipt != nil {
+				s.drain(ch, receipt)
+			}
+			return
+		}
+		s.mu.Unlock()
+
+		s.deliver(broadcasterUserID, ch, receipt)
+	}
+}
+
+// drain fails the receipt and every message still queued in the channel with ErrClosed.
+func (s *Sender) drain(ch *channel, receipt *Receipt) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for ; receipt != nil; receipt = ch.next() {
+		receipt.finish(ErrClosed)
+	}
+}
+
+// prune removes the channels without a worker whose pacing and dedup state expired, so channels that stopped
+// receiving messages are not kept. The caller must hold s.mu.
+func (s *Sender) prune(now time.Time) {
+	expiry := max(s.config.DedupWindow, s.config.Interval)
+	for broadcasterUserID, ch := range s.channels {
+		if ch.running || now.Sub(ch.lastAt) < expiry {
+			continue
+		}
+		if n := len(ch.sent); n > 0 && now.Sub(ch.sent[n-1]) < s.config.Interval {
+			continue
+		}
+		delete(s.channels, broadcasterUserID)
+	}
+}
+
+// next removes and returns the oldest message of the highest non-empty priority lane, or nil.
+func (ch *channel) next() *Receipt {
+	for lane := len(ch.lanes) - 1; lane >= 0; lane-- {
+		if len(ch.lanes[lane]) > 0 {
+			receipt := ch.lanes[lane][0]
+			ch.lanes[lane][0] = nil
+			ch.lanes[lane] = ch.lanes[lane][1:]
+			return receipt
+		}
+	}
+	return nil
+}
+
+func (s *Sender) deliver(broadcasterUserID int, ch *channel, receipt *Receipt) {
+	for i, part := range receipt.parts {
+		if i > 0 {
+			if err := s.wait(ch); err != nil {
+				receipt.finish(ErrClosed)
+				return
+			}
+		}
+		ch.sent = append(ch.sent, time.Now())
+
+		response, err := s.send(receipt.message, part)
+		if err != nil {
+			s.logger.WarnContext(s.ctx, "kick: chat message delivery failed",
+				slog.Int("broadcaster_user_id", broadcasterUserID),
+				slog.Int("part", i+1),
+				slog.Int("parts", len(receipt.parts)),
+				slog.Any("error", err),
+			)
+			receipt.finish(err)
+			return
+		}
+
+		receipt.deliveries = append(receipt.deliveries, Delivery{
+			Part:      i + 1,
+			Parts:     len(receipt.parts),
+			Content:   part,
+			MessageID: response.Data.MessageID,
+			Sent:      response.Data.IsSent,
+		})
+	}
+
+	receipt.finish(nil)
+}
+
+// wait blocks until the channel may send another message without exceeding MessagesPerInterval.
+func (s *Sender) wait(ch *channel) error {
+	if len(ch.sent) >= s.config.MessagesPerInterval {
+		delay := time.Until(ch.sent[0].Add(s.config.Interval))
+		if delay > 0 {
+			timer := time.NewTimer(delay)
+			defer timer.Stop()
+
+			select {
+			case <-s.ctx.Done():
+				return s.ctx.Err()
+			case <-timer.C:
+			}
+		}
+		ch.sent = ch.sent[1:]
+	}
+
+	return s.ctx.Err()
+}
+
+// send sends a part. It is not cancelled by Close, which waits for the part to be sent.
+func (s *Sender) send(message Message, content string) (*kickapitypes.SendChatResponse, error) {
+	ctx := context.WithoutCancel(s.ctx)
+
+	accessToken, err := s.config.AccessToken(ctx, message.BroadcasterUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if message.AsUser {
+		return s.config.Chat.SendChatMessageAsUser(ctx, accessToken, message.BroadcasterUserID, message.ReplyToMessageID, content)
+	}
+	return s.config.Chat.SendChatMessageAsBot(ctx, accessToken, message.ReplyToMessageID, content)
+}
@@ -0,0 +1,86 @@
+package kicksender
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrTooManyParts is returned when text needs so many parts that their numbers leave no room for the text.
+var ErrTooManyParts = errors.New("kicksender: message needs too many parts to number them within the maximum length")
+
+// Split splits text into parts of at most maxLength runes, breaking at word boundaries. When the text does not
+// fit in one part every part ends with its number, e.g. " (1/3)", which counts towards maxLength.
+// Words longer than a part are broken inside the word. Text that is empty or only whitespace has no parts.
+// ErrTooManyParts is returned when the part numbers alone would take up maxLength.
+func Split(text string, maxLength int) ([]string, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return nil, nil
+	}
+	if utf8.RuneCountInString(text) <= maxLength {
+		return []string{text}, nil
+	}
+
+	// The suffix length depends on the number of parts, so split again until the count is stable.
+	parts := 2
+	for {
+		limit := maxLength - utf8.RuneCountInString(suffix(parts, parts))
+		if limit < 1 {
+			return nil, ErrTooManyParts
+		}
+
+		chunks := splitWords(text, limit)
+		if len(chunks) == 1 {
+			return chunks, nil
+		}
+		if len(suffix(len(chunks), len(chunks))) <= len(suffix(parts, parts)) {
+			for i := range chunks {
+				chunks[i] += suffix(i+1, len(chunks))
+			}
+			return chunks, nil
+		}
+		parts = len(chunks)
+	}
+}
+
+func suffix(part, parts int) string {
+	return fmt.Sprintf(" (%d/%d)", part, parts)
+}
+
+// splitWords splits text into chunks of at most limit runes without breaking words that fit in a chunk.
+func splitWords(text string, limit int) []string {
+	var (
+		chunks []string
+		chunk  []rune
+	)
+
+	for _, word := range strings.Fields(text) {
+		runes := []rune(word)
+
+		if len(chunk) > 0 && len(chunk)+1+len(runes) > limit {
+			chunks = append(chunks, string(chunk))
+			chunk = chunk[:0]
+		}
+
+		for len(runes) > limit {
+			if len(chunk) > 0 {
+				chunks = append(chunks, string(chunk))
+				chunk = chunk[:0]
+			}
+			chunks = append(chunks, string(runes[:limit]))
+			runes = runes[limit:]
+		}
+
+		if len(chunk) > 0 {
+			chunk = append(chunk, ' ')
+		}
+		chunk = append(chunk, runes...)
+	}
+	if len(chunk) > 0 {
+		chunks = append(chunks, string(chunk))
+	}
+
+	return chunks
+}
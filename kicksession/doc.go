@@ -0,0 +1,31 @@
+// Package kicksession tracks stream sessions from webhook events and summarizes them when the stream ends.
+//
+// A Tracker starts a session on the LivestreamStatusUpdated event of a stream going live and ends it when the
+// stream goes offline. While the stream is live, LivestreamMetadataUpdated events build the title and category
+// timeline and the follow, subscription, kicks and ban events are counted, gifts attributed to their gifter.
+// Sessions are kept in a Store, a MemoryStore unless another is configured:
+//
+//	tracker, err := kicksession.NewTracker(kicksession.TrackerConfig{
+//		OnSummary: func(ctx context.Context, summary kicksession.Summary) {
+//			log.Println(summary)
+//		},
+//	})
+//	if err != nil {
+//		log.Fatalf("could not create tracker: %v", err)
+//	}
+//
+//	if err := tracker.Register(webhookClient); err != nil {
+//		log.Fatalf("could not register handlers: %v", err)
+//	}
+//
+// The On methods record a single event, e.g. to feed the events of a kicklivewatch.Watcher when webhooks are not
+// available. They take the message ID of the webhook so redelivered events are counted once; events without a
+// message ID pass an empty one:
+//
+//	watcher, err := kicklivewatch.NewWatcher(kicklivewatch.WatcherConfig{
+//		// ...
+//		OnStatus: func(ctx context.Context, event kickwebhooktypes.LivestreamStatusUpdated) {
+//			_ = tracker.OnLivestreamStatusUpdated(ctx, "", event)
+//		},
+//	})
+package kicksession
@@ -0,0 +1,233 @@
+package kicksession
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// topSupporters is how many supporters a summary lists per ranking.
+const topSupporters = 5
+
+// TitleChange is the title of a stream from At on.
+type TitleChange struct {
+	At    time.Time `json:"at"`
+	Title string    `json:"title"`
+}
+
+// CategoryChange is the category of a stream from At on.
+type CategoryChange struct {
+	At           time.Time `json:"at"`
+	CategoryID   int       `json:"category_id"`
+	CategoryName string    `json:"category_name"`
+}
+
+// Counts counts the events of a stream.
+type Counts struct {
+	Follows          int `json:"follows"`
+	NewSubscriptions int `json:"new_subscriptions"`
+	Renewals         int `json:"renewals"`
+
+	// GiftedSubscriptions is the number of subscriptions gifted, not the number of gift events.
+	GiftedSubscriptions int `json:"gifted_subscriptions"`
+
+	// Kicks is the amount of kicks gifted.
+	Kicks int `json:"kicks"`
+
+	// Bans are permanent bans, Timeouts are bans with an expiry.
+	Bans     int `json:"bans"`
+	Timeouts int `json:"timeouts"`
+}
+
+// Supporter is what a user gifted during a stream. Anonymous gifts are counted but not attributed.
+type Supporter struct {
+	UserID              int    `json:"user_id"`
+	Username            string `json:"username"`
+	GiftedSubscriptions int    `json:"gifted_subscriptions"`
+	Kicks               int    `json:"kicks"`
+}
+
+// Session is a stream of a channel, from going live to going offline.
+type Session struct {
+	// ID is unique per broadcaster and start of the stream.
+	ID                string    `json:"id"`
+	BroadcasterUserID int       `json:"broadcaster_user_id"`
+	StartedAt         time.Time `json:"started_at"`
+
+	// EndedAt is zero while the stream is live.
+	EndedAt time.Time `json:"ended_at,omitzero"`
+
+	// Titles and Categories are ordered by time, oldest first.
+	Titles     []TitleChange    `json:"titles"`
+	Categories []CategoryChange `json:"categories"`
+
+	Counts Counts `json:"counts"`
+
+	// Supporters is keyed by user ID.
+	Supporters map[int]Supporter `json:"supporters"`
+
+	// MessageIDs are the webhook message IDs recorded in the session, so redelivered events are counted once.
+	MessageIDs map[string]struct{} `json:"message_ids,omitempty"`
+}
+
+func newSession(broadcasterUserID int, startedAt time.Time) Session {
+	return Session{
+		ID:                strconv.Itoa(broadcasterUserID) + "-" + strconv.FormatInt(startedAt.Unix(), 10),
+		BroadcasterUserID: broadcasterUserID,
+		StartedAt:         startedAt,
+		Titles:            []TitleChange{},
+		Categories:        []CategoryChange{},
+		Supporters:        map[int]Supporter{},
+		MessageIDs:        map[string]struct{}{},
+	}
+}
+
+// Live reports whether the stream has not ended.
+func (s Session) Live() bool {
+	return s.EndedAt.IsZero()
+}
+
+// Title returns the latest title of the stream.
+func (s Session) Title() string {
+	if len(s.Titles) == 0 {
+		return ""
+	}
+	return s.Titles[len(s.Titles)-1].Title
+}
+
+// clone returns a copy of the session that shares no slices or maps with it.
+func (s Session) clone() Session {
+	s.Titles = slices.Clone(s.Titles)
+	s.Categories = slices.Clone(s.Categories)
+	s.Supporters = maps.Clone(s.Supporters)
+	s.MessageIDs = maps.Clone(s.MessageIDs)
+	return s
+}
+
+// CategoryTime is how long a stream spent in a category.
+type CategoryTime struct {
+	CategoryID   int           `json:"category_id"`
+	CategoryName string        `json:"category_name"`
+	Duration     time.Duration `json:"duration"`
+}
+
+// Summary is the outcome of a stream.
+type Summary struct {
+	SessionID         string        `json:"session_id"`
+	BroadcasterUserID int           `json:"broadcaster_user_id"`
+	StartedAt         time.Time     `json:"started_at"`
+	EndedAt           time.Time     `json:"ended_at"`
+	Duration          time.Duration `json:"duration"`
+
+	// Titles is every title of the stream, oldest first.
+	Titles []TitleChange `json:"titles"`
+
+	// Categories is ordered by the time spent in the category, longest first.
+	Categories []CategoryTime `json:"categories"`
+
+	Counts Counts `json:"counts"`
+
+	// TopGifters and TopKickSenders are the supporters with the most gifted subscriptions and kicks, at most 5 each.
+	TopGifters     []Supporter `json:"top_gifters"`
+	TopKickSenders []Supporter `json:"top_kick_senders"`
+}
+
+// Summary summarizes the session. A live session is summarized up to now.
+func (s Session) Summary() Summary {
+	endedAt := s.EndedAt
+	if endedAt.IsZero() {
+		endedAt = time.Now()
+	}
+
+	summary := Summary{
+		SessionID:         s.ID,
+		BroadcasterUserID: s.BroadcasterUserID,
+		StartedAt:         s.StartedAt,
+		EndedAt:           endedAt,
+		Duration:          endedAt.Sub(s.StartedAt),
+		Titles:            slices.Clone(s.Titles),
+		Categories:        []CategoryTime{},
+		Counts:            s.Counts,
+	}
+
+	categories := map[int]*CategoryTime{}
+	for i, change := range s.Categories {
+		until := endedAt
+		if i+1 < len(s.Categories) {
+			until = s.Categories[i+1].At
+		}
+		category, ok := categories[change.CategoryID]
+		if !ok {
+			category = &CategoryTime{CategoryID: change.CategoryID}
+			categories[change.CategoryID] = category
+		}
+		category.CategoryName = change.CategoryName
+		category.Duration += max(until.Sub(change.At), 0)
+	}
+	for _, category := range categories {
+		summary.Categories = append(summary.Categories, *category)
+	}
+	slices.SortFunc(summary.Categories, func(a, b CategoryTime) int {
+		return cmp.Or(cmp.Compare(b.Duration, a.Duration), cmp.Compare(a.CategoryID, b.CategoryID))
+	})
+
+	summary.TopGifters = top(s.Supporters, func(supporter Supporter) int { return supporter.GiftedSubscriptions })
+	summary.TopKickSenders = top(s.Supporters, func(supporter Supporter) int { return supporter.Kicks })
+	return summary
+}
+
+func top(supporters map[int]Supporter, score func(Supporter) int) []Supporter {
+	ranked := []Supporter{}
+	for _, supporter := range supporters {
+		if score(supporter) > 0 {
+			ranked = append(ranked, supporter)
+		}
+	}
+	slices.SortFunc(ranked, func(a, b Supporter) int {
+		return cmp.Or(cmp.Compare(score(b), score(a)), cmp.Compare(a.UserID, b.UserID))
+	})
+	return ranked[:min(len(ranked), topSupporters)]
+}
+
+// String formats the summary as plain text, e.g. for a chat message or a Discord post.
+func (s Summary) String() string {
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "Stream of %s", s.Duration.Round(time.Minute))
+	if len(s.Titles) > 0 {
+		fmt.Fprintf(&builder, ": %q", s.Titles[len(s.Titles)-1].Title)
+	}
+	builder.WriteString("\n")
+
+	if len(s.Categories) > 0 {
+		categories := make([]string, len(s.Categories))
+		for i, category := range s.Categories {
+			categories[i] = fmt.Sprintf("%s %s", category.CategoryName, category.Duration.Round(time.Minute))
+		}
+		fmt.Fprintf(&builder, "Categories: %s\n", strings.Join(categories, ", "))
+	}
+
+	fmt.Fprintf(&builder, "Follows %d, new subs %d, renewals %d, gifted subs %d, kicks %d, bans %d, timeouts %d\n",
+		s.Counts.Follows, s.Counts.NewSubscriptions, s.Counts.Renewals, s.Counts.GiftedSubscriptions,
+		s.Counts.Kicks, s.Counts.Bans, s.Counts.Timeouts)
+
+	writeSupporters(&builder, "Top gifters", s.TopGifters, func(supporter Supporter) int { return supporter.GiftedSubscriptions })
+	writeSupporters(&builder, "Top kick senders", s.TopKickSenders, func(supporter Supporter) int { return supporter.Kicks })
+
+	return strings.TrimSuffix(builder.String(), "\n")
+}
+
+func writeSupporters(builder *strings.Builder, label string, supporters []Supporter, score func(Supporter) int) {
+	if len(supporters) == 0 {
+		return
+	}
+	names := make([]string, len(supporters))
+	for i, supporter := range supporters {
+		names[i] = fmt.Sprintf("%s (%d)", cmp.Or(supporter.Username, strconv.Itoa(supporter.UserID)), score(supporter))
+	}
+	fmt.Fprintf(builder, "%s: %s\n", label, strings.Join(names, ", "))
+}
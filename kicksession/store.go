@@ -0,0 +1,71 @@
+package kicksession
+
+import (
+	"context"
+	"sync"
+)
+
+// Store persists sessions. Implementations must be safe for concurrent use.
+type Store interface {
+	// Current returns the live session of the broadcaster, nil when the broadcaster is offline.
+	Current(ctx context.Context, broadcasterUserID int) (*Session, error)
+
+	// Save creates or replaces the session with the same ID.
+	Save(ctx context.Context, session Session) error
+
+	// Sessions returns the sessions of the broadcaster, oldest first.
+	Sessions(ctx context.Context, broadcasterUserID int) ([]Session, error)
+}
+
+// MemoryStore is a Store that keeps the sessions in memory.
+type MemoryStore struct {
+	mu       sync.RWMutex
+	sessions map[int][]Session
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{sessions: map[int][]Session{}}
+}
+
+func (s *MemoryStore) Current(_ context.Context, broadcasterUserID int) (*Session, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sessions := s.sessions[broadcasterUserID]
+	for i := len(sessions) - 1; i >= 0; i-- {
+		if sessions[i].Live() {
+			session := sessions[i].clone()
+			return &session, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *MemoryStore) Save(_ context.Context, session Session) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	sessions := s.sessions[session.BroadcasterUserID]
+	for i := range sessions {
+		if sessions[i].ID == session.ID {
+			sessions[i] = session.clone()
+			return nil
+		}
+	}
+	s.sessions[session.BroadcasterUserID] = append(sessions, session.clone())
+	return nil
+}
+
+func (s *MemoryStore) Sessions(_ context.Context, broadcasterUserID int) ([]Session, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	sessions := make([]Session, len(s.sessions[broadcasterUserID]))
+	for i, session := range s.sessions[broadcasterUserID] {
+		sessions[i] = session.clone()
+	}
+	return sessions, nil
+}
@@ -0,0 +1,293 @@
+package kicksession
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/henrikah/kick-go-sdk/v2/internal/logging"
+	"github.com/henrikah/kick-go-sdk/v2/kicktime"
+	"github.com/henrikah/kick-go-sdk/v2/kickwebhooktypes"
+)
+
+// WebhookRegistrar is the part of the webhook client a Tracker registers its handlers with.
+type WebhookRegistrar interface {
+	RegisterLivestreamStatusUpdatedHandler(handler func(http.ResponseWriter, *http.Request, kickwebhooktypes.KickWebhookHeaders, kickwebhooktypes.LivestreamStatusUpdated)) error
+	RegisterLivestreamMetadataUpdatedHandler(handler func(http.ResponseWriter, *http.Request, kickwebhooktypes.KickWebhookHeaders, kickwebhooktypes.LivestreamMetadataUpdated)) error
+	RegisterChannelFollowedHandler(handler func(http.ResponseWriter, *http.Request, kickwebhooktypes.KickWebhookHeaders, kickwebhooktypes.ChannelFollowed)) error
+	RegisterChannelSubscriptionNewHandler(handler func(http.ResponseWriter, *http.Request, kickwebhooktypes.KickWebhookHeaders, kickwebhooktypes.ChannelSubscriptionNew)) error
+	RegisterChannelSubscriptionRenewalHandler(handler func(http.ResponseWriter, *http.Request, kickwebhooktypes.KickWebhookHeaders, kickwebhooktypes.ChannelSubscriptionRenewal)) error
+	RegisterChannelSubscriptionGiftsHandler(handler func(http.ResponseWriter, *http.Request, kickwebhooktypes.KickWebhookHeaders, kickwebhooktypes.ChannelSubscriptionGifts)) error
+	RegisterKicksGiftedHandler(handler func(http.ResponseWriter, *http.Request, kickwebhooktypes.KickWebhookHeaders, kickwebhooktypes.KicksGifted)) error
+	RegisterModerationBannedHandler(handler func(http.ResponseWriter, *http.Request, kickwebhooktypes.KickWebhookHeaders, kickwebhooktypes.ModerationBanned)) error
+}
+
+// TrackerConfig configures a Tracker.
+type TrackerConfig struct {
+	// Store persists the sessions. Defaults to a MemoryStore when nil.
+	Store Store
+
+	// OnSummary receives the summary of every session that ended.
+	OnSummary func(ctx context.Context, summary Summary)
+
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+
+	// Logger receives events that could not be recorded by the registered webhook handlers. Defaults to
+	// slog.Default() when nil.
+	Logger *slog.Logger
+}
+
+// Tracker builds stream sessions from webhook events. Events of a broadcaster that is not live are ignored.
+// It is safe for concurrent use.
+type Tracker struct {
+	config TrackerConfig
+	logger *slog.Logger
+
+	// mu serializes the read-modify-write of sessions in the store.
+	mu sync.Mutex
+}
+
+// NewTracker creates a new Tracker with the provided configuration.
+func NewTracker(config TrackerConfig) (*Tracker, error) {
+	if config.Store == nil {
+		config.Store = NewMemoryStore()
+	}
+	if config.Now == nil {
+		config.Now = time.Now
+	}
+
+	return &Tracker{
+		config: config,
+		logger: logging.OrDefault(config.Logger),
+	}, nil
+}
+
+// Current returns the live session of the broadcaster, nil when the broadcaster is offline.
+func (t *Tracker) Current(ctx context.Context, broadcasterUserID int) (*Session, error) {
+	return t.config.Store.Current(ctx, broadcasterUserID)
+}
+
+// Sessions returns the sessions of the broadcaster, oldest first.
+func (t *Tracker) Sessions(ctx context.Context, broadcasterUserID int) ([]Session, error) {
+	return t.config.Store.Sessions(ctx, broadcasterUserID)
+}
+
+// OnLivestreamStatusUpdated starts a session when the stream goes live and ends it when the stream goes offline.
+// A session still live when the broadcaster goes live again is ended at the start of the new stream. OnSummary
+// runs after the tracker is unlocked, so it can call the tracker.
+//
+// Every On method takes the message ID of the webhook. An event with a message ID already recorded in the session
+// is ignored, so redelivered events are counted once. An empty message ID is never deduplicated.
+func (t *Tracker) OnLivestreamStatusUpdated(ctx context.Context, messageID string, event kickwebhooktypes.LivestreamStatusUpdated) error {
+	ended, err := t.updateStatus(ctx, messageID, event)
+	if ended != nil && t.config.OnSummary != nil {
+		t.config.OnSummary(ctx, ended.Summary())
+	}
+	return err
+}
+
+// updateStatus starts or ends the session of the broadcaster and returns the session it ended, if any.
+func (t *Tracker) updateStatus(ctx context.Context, messageID string, event kickwebhooktypes.LivestreamStatusUpdated) (*Session, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	broadcasterUserID := event.Broadcaster.UserID
+	current, err := t.config.Store.Current(ctx, broadcasterUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !event.IsLive {
+		if current == nil {
+			return nil, nil
+		}
+		return t.end(ctx, *current, t.timeOf(event.EndedAt))
+	}
+
+	startedAt := t.timeOf(event.StartedAt)
+	var ended *Session
+	if current != nil {
+		if current.StartedAt.Equal(startedAt) {
+			// Kick delivered the event again.
+			return nil, nil
+		}
+		if ended, err = t.end(ctx, *current, startedAt); err != nil {
+			return nil, err
+		}
+	}
+
+	session := newSession(broadcasterUserID, startedAt)
+	if event.Title != "" {
+		session.Titles = append(session.Titles, TitleChange{At: startedAt, Title: event.Title})
+	}
+	if messageID != "" {
+		session.MessageIDs[messageID] = struct{}{}
+	}
+	return ended, t.config.Store.Save(ctx, session)
+}
+
+// end saves the session as ended at endedAt and returns it. The caller must hold t.mu.
+func (t *Tracker) end(ctx context.Context, session Session, endedAt time.Time) (*Session, error) {
+	session.EndedAt = endedAt
+	if err := t.config.Store.Save(ctx, session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// OnLivestreamMetadataUpdated adds the title and category of the stream to the timeline when they changed.
+func (t *Tracker) OnLivestreamMetadataUpdated(ctx context.Context, messageID string, event kickwebhooktypes.LivestreamMetadataUpdated) error {
+	now := t.config.Now()
+	return t.update(ctx, event.Broadcaster.UserID, messageID, func(session *Session) {
+		if title := event.Metadata.Title; title != "" && title != session.Title() {
+			session.Titles = append(session.Titles, TitleChange{At: now, Title: title})
+		}
+
+		category := event.Metadata.Category
+		if category.ID == 0 {
+			return
+		}
+		if n := len(session.Categories); n > 0 && session.Categories[n-1].CategoryID == category.ID {
+			return
+		}
+		// The first category is taken to apply from the start of the stream.
+		at := now
+		if len(session.Categories) == 0 {
+			at = session.StartedAt
+		}
+		session.Categories = append(session.Categories, CategoryChange{At: at, CategoryID: category.ID, CategoryName: category.Name})
+	})
+}
+
+// OnChannelFollowed counts the follow.
+func (t *Tracker) OnChannelFollowed(ctx context.Context, messageID string, event kickwebhooktypes.ChannelFollowed) error {
+	return t.update(ctx, event.Broadcaster.UserID, messageID, func(session *Session) {
+		session.Counts.Follows++
+	})
+}
+
+// OnChannelSubscriptionNew counts the new subscription.
+func (t *Tracker) OnChannelSubscriptionNew(ctx context.Context, messageID string, event kickwebhooktypes.ChannelSubscriptionNew) error {
+	return t.update(ctx, event.Broadcaster.UserID, messageID, func(session *Session) {
+		session.Counts.NewSubscriptions++
+	})
+}
+
+// OnChannelSubscriptionRenewal counts the renewal.
+func (t *Tracker) OnChannelSubscriptionRenewal(ctx context.Context, messageID string, event kickwebhooktypes.ChannelSubscriptionRenewal) error {
+	return t.update(ctx, event.Broadcaster.UserID, messageID, func(session *Session) {
+		session.Counts.Renewals++
+	})
+}
+
+// OnChannelSubscriptionGifts counts every gifted subscription and attributes them to the gifter.
+func (t *Tracker) OnChannelSubscriptionGifts(ctx context.Context, messageID string, event kickwebhooktypes.ChannelSubscriptionGifts) error {
+	return t.update(ctx, event.Broadcaster.UserID, messageID, func(session *Session) {
+		session.Counts.GiftedSubscriptions += len(event.Giftees)
+		session.support(event.Gifter, func(supporter *Supporter) {
+			supporter.GiftedSubscriptions += len(event.Giftees)
+		})
+	})
+}
+
+// OnKicksGifted counts the kicks and attributes them to the sender.
+func (t *Tracker) OnKicksGifted(ctx context.Context, messageID string, event kickwebhooktypes.KicksGifted) error {
+	return t.update(ctx, event.Broadcaster.UserID, messageID, func(session *Session) {
+		session.Counts.Kicks += event.Gift.Amount
+		session.support(event.Sender, func(supporter *Supporter) {
+			supporter.Kicks += event.Gift.Amount
+		})
+	})
+}
+
+// OnModerationBanned counts the ban, or the timeout when the ban expires.
+func (t *Tracker) OnModerationBanned(ctx context.Context, messageID string, event kickwebhooktypes.ModerationBanned) error {
+	return t.update(ctx, event.Broadcaster.UserID, messageID, func(session *Session) {
+		if event.Metadata.ExpiresAt.Time().IsZero() {
+			session.Counts.Bans++
+		} else {
+			session.Counts.Timeouts++
+		}
+	})
+}
+
+func (s *Session) support(user kickwebhooktypes.User, apply func(*Supporter)) {
+	if user.IsAnonymous || user.UserID == 0 {
+		return
+	}
+	supporter := s.Supporters[user.UserID]
+	supporter.UserID = user.UserID
+	if user.Username != "" {
+		supporter.Username = user.Username
+	}
+	apply(&supporter)
+	s.Supporters[user.UserID] = supporter
+}
+
+// update applies the change to the live session of the broadcaster and saves it, unless the session already
+// recorded the message ID.
+func (t *Tracker) update(ctx context.Context, broadcasterUserID int, messageID string, apply func(*Session)) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	session, err := t.config.Store.Current(ctx, broadcasterUserID)
+	if err != nil || session == nil {
+		return err
+	}
+	if session.Supporters == nil {
+		session.Supporters = map[int]Supporter{}
+	}
+	if session.MessageIDs == nil {
+		session.MessageIDs = map[string]struct{}{}
+	}
+	if messageID != "" {
+		if _, seen := session.MessageIDs[messageID]; seen {
+			return nil
+		}
+		session.MessageIDs[messageID] = struct{}{}
+	}
+
+	apply(session)
+	return t.config.Store.Save(ctx, *session)
+}
+
+func (t *Tracker) timeOf(at kicktime.KickTime) time.Time {
+	if at.Time().IsZero() {
+		return t.config.Now()
+	}
+	return at.Time()
+}
+
+// Register registers the handlers of every event the tracker uses with the webhook client. The handlers respond
+// with 500 when an event could not be recorded, so Kick delivers it again.
+func (t *Tracker) Register(client WebhookRegistrar) error {
+	return errors.Join(
+		client.RegisterLivestreamStatusUpdatedHandler(handler(t, t.OnLivestreamStatusUpdated)),
+		client.RegisterLivestreamMetadataUpdatedHandler(handler(t, t.OnLivestreamMetadataUpdated)),
+		client.RegisterChannelFollowedHandler(handler(t, t.OnChannelFollowed)),
+		client.RegisterChannelSubscriptionNewHandler(handler(t, t.OnChannelSubscriptionNew)),
+		client.RegisterChannelSubscriptionRenewalHandler(handler(t, t.OnChannelSubscriptionRenewal)),
+		client.RegisterChannelSubscriptionGiftsHandler(handler(t, t.OnChannelSubscriptionGifts)),
+		client.RegisterKicksGiftedHandler(handler(t, t.OnKicksGifted)),
+		client.RegisterModerationBannedHandler(handler(t, t.OnModerationBanned)),
+	)
+}
+
+func handler[T any](t *Tracker, record func(context.Context, string, T) error) func(http.ResponseWriter, *http.Request, kickwebhooktypes.KickWebhookHeaders, T) {
+	return func(writer http.ResponseWriter, request *http.Request, kickHeaders kickwebhooktypes.KickWebhookHeaders, event T) {
+		if err := record(request.Context(), kickHeaders.MessageID, event); err != nil {
+			t.logger.ErrorContext(request.Context(), "kick: recording stream session event failed",
+				slog.String("message_id", kickHeaders.MessageID),
+				slog.String("event_type", kickHeaders.Type),
+				slog.Any("error", err),
+			)
+			writer.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		writer.WriteHeader(http.StatusOK)
+	}
+}
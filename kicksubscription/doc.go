@@ -0,0 +1,45 @@
+// Package kicksubscription manages webhook event subscriptions declaratively on top of the EventsSubscription service.
+//
+// A Reconciler compares a desired set of subscriptions with the subscriptions Kick reports and creates the
+// missing ones and deletes the stale and duplicate ones:
+//
+//	reconciler, err := kicksubscription.NewReconciler(kicksubscription.ReconcilerConfig{
+//		EventsSubscription: apiClient.EventsSubscription(),
+//	})
+//	if err != nil {
+//		log.Fatalf("could not create reconciler: %v", err)
+//	}
+//
+//	report, err := reconciler.Reconcile(ctx, appAccessToken, []kicksubscription.Subscription{
+//		{BroadcasterUserID: 12345, Event: kickwebhookenum.ChatMessageSent, Version: 1},
+//		{BroadcasterUserID: 12345, Event: kickwebhookenum.ChannelFollowed, Version: 1},
+//	})
+//	if reconcileErr := kickerrors.IsReconcileError(err); reconcileErr != nil {
+//		log.Printf("%d changes failed", len(reconcileErr.Failed))
+//	} else if err != nil {
+//		log.Fatalf("could not reconcile subscriptions: %v", err)
+//	}
+//	log.Printf("created %d, deleted %d", len(report.Created), len(report.Deleted))
+//
+// Apps serving many broadcasters can use a Manager instead. It enrolls broadcasters with a standard event set,
+// paces the create requests, keeps the subscription IDs in a Store and periodically repairs drift:
+//
+//	manager, err := kicksubscription.NewManager(kicksubscription.ManagerConfig{
+//		EventsSubscription: apiClient.EventsSubscription(),
+//		Events: []kicksubscription.Event{
+//			{Type: kickwebhookenum.ChatMessageSent},
+//			{Type: kickwebhookenum.LivestreamStatusUpdated},
+//		},
+//	})
+//	if err != nil {
+//		log.Fatalf("could not create manager: %v", err)
+//	}
+//
+//	if _, err := manager.Enroll(ctx, appAccessToken, 12345); err != nil {
+//		log.Printf("could not enroll broadcaster: %v", err)
+//	}
+//
+//	go manager.Run(ctx, func(ctx context.Context) (string, error) {
+//		return appAccessToken, nil
+//	})
+package kicksubscription
@@ -0,0 +1,322 @@
+package kicksubscription
+
+import (
+	"cmp"
+	"context"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/henrikah/kick-go-sdk/v2/enums/kickwebhookenum"
+	"github.com/henrikah/kick-go-sdk/v2/internal/logging"
+	"github.com/henrikah/kick-go-sdk/v2/internal/ratelimit"
+	"github.com/henrikah/kick-go-sdk/v2/kickcontracts"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+)
+
+const (
+	defaultCreateInterval = 500 * time.Millisecond
+	defaultVerifyInterval = 15 * time.Minute
+)
+
+// Event is an event of the standard event set every enrolled broadcaster is subscribed to.
+type Event struct {
+	Type kickwebhookenum.WebhookType
+	// Version of the event payload. Defaults to 1 when zero.
+	Version int
+}
+
+// ManagerConfig configures a Manager.
+type ManagerConfig struct {
+	// EventsSubscription is the service used to read, create and delete subscriptions.
+	EventsSubscription kickcontracts.EventsSubscription
+
+	// Events is the standard event set every enrolled broadcaster is subscribed to.
+	Events []Event
+
+	// Store persists the subscription IDs of every enrolled broadcaster. Defaults to a MemoryStore.
+	Store Store
+
+	// CreateInterval is the minimum time between two create requests. Defaults to 500 milliseconds.
+	CreateInterval time.Duration
+
+	// VerifyInterval is how often Run verifies the enrollments. Defaults to 15 minutes.
+	VerifyInterval time.Duration
+
+	// Logger receives the outcome of the periodic verification in Run. Defaults to slog.Default() when nil.
+	Logger *slog.Logger
+}
+
+// Manager enrolls broadcasters with a standard event set using an app access token and keeps them subscribed.
+//
+// Enroll and Unenroll are serialized with each other and with the store updates of Verify, so the store never
+// sees interleaved updates. Verify reconciles without holding that lock and only one Verify runs at a time.
+type Manager struct {
+	config     ManagerConfig
+	limiter    *ratelimit.Limiter
+	reconciler *Reconciler
+	logger     *slog.Logger
+	mu         sync.Mutex
+	verifying  sync.Mutex
+}
+
+// NewManager creates a new Manager with the provided configuration.
+func NewManager(config ManagerConfig) (*Manager, error) {
+	if err := kickerrors.ValidateNotNil("EventsSubscription", config.EventsSubscription); err != nil {
+		return nil, err
+	}
+	if err := kickerrors.ValidateMinItems("Events", config.Events, 1); err != nil {
+		return nil, err
+	}
+	for _, event := range config.Events {
+		if err := kickerrors.ValidateNotEmpty("Events.Type", string(event.Type)); err != nil {
+			return nil, err
+		}
+	}
+
+	if config.Store == nil {
+		config.Store = NewMemoryStore()
+	}
+	config.CreateInterval = cmp.Or(config.CreateInterval, defaultCreateInterval)
+	config.VerifyInterval = cmp.Or(config.VerifyInterval, defaultVerifyInterval)
+
+	reconciler, err := NewReconciler(ReconcilerConfig{
+		EventsSubscription: config.EventsSubscription,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	manager := &Manager{
+		config:     config,
+		limiter:    ratelimit.New(config.CreateInterval),
+		reconciler: reconciler,
+		logger:     logging.OrDefault(config.Logger),
+	}
+	reconciler.limiter = manager.limiter
+
+	return manager, nil
+}
+
+// Enroll subscribes the broadcaster to every event of the standard set it is not subscribed to yet and stores the
+// subscription IDs. Subscriptions the broadcaster already has on Kick are adopted instead of created again, so
+// enrolling an enrolled broadcaster only creates the events that are missing from both the store and Kick.
+//
+// The stored subscriptions are returned, together with a *kickerrors.ReconcileError when some events failed.
+func (m *Manager) Enroll(ctx context.Context, accessToken string, broadcasterUserID int) ([]ExistingSubscription, error) {
+	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
+		return nil, err
+	}
+	if err := kickerrors.ValidateBroadcasterUserID(broadcasterUserID); err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored, _, err := m.config.Store.Load(ctx, broadcasterUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[Subscription]bool, len(stored))
+	for _, subscription := range stored {
+		existing[subscription.Subscription] = true
+	}
+
+	current, err := m.config.EventsSubscription.GetEventSubscriptions(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := m.subscriptions(broadcasterUserID)
+	for _, data := range oldestFirst(current.Data) {
+		adopted := fromData(data)
+		if adopted.BroadcasterUserID != broadcasterUserID || existing[adopted.Subscription] || !slices.Contains(wanted, adopted.Subscription) {
+			continue
+		}
+		existing[adopted.Subscription] = true
+		stored = append(stored, adopted)
+	}
+
+	var missing []Subscription
+	for _, subscription := range wanted {
+		if !existing[subscription] {
+			missing = append(missing, subscription)
+		}
+	}
+
+	var failed []kickerrors.SubscriptionChangeError
+	if len(missing) > 0 {
+		var created []ExistingSubscription
+		created, failed = create(ctx, m.config.EventsSubscription, m.limiter, accessToken, broadcasterUserID, missing)
+		stored = append(stored, created...)
+	}
+
+	if err := m.config.Store.Save(ctx, broadcasterUserID, stored); err != nil {
+		return nil, err
+	}
+
+	if len(failed) > 0 {
+		return stored, kickerrors.SetReconcileError(len(missing), failed)
+	}
+
+	return stored, nil
+}
+
+// Unenroll deletes the stored subscriptions of the broadcaster and removes it from the store.
+// Unenrolling a broadcaster that is not enrolled does nothing.
+func (m *Manager) Unenroll(ctx context.Context, accessToken string, broadcasterUserID int) error {
+	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored, enrolled, err := m.config.Store.Load(ctx, broadcasterUserID)
+	if err != nil || !enrolled {
+		return err
+	}
+
+	if len(stored) > 0 {
+		subscriptionIDs := make([]string, len(stored))
+		for i, subscription := range stored {
+			subscriptionIDs[i] = subscription.ID
+		}
+
+		if err := m.config.EventsSubscription.DeleteEventSubscriptions(ctx, accessToken, subscriptionIDs); err != nil {
+			return err
+		}
+	}
+
+	return m.config.Store.Delete(ctx, broadcasterUserID)
+}
+
+// Verify compares the enrollments in the store with the subscriptions Kick reports and repairs any drift:
+// missing subscriptions are recreated, duplicate and unknown subscriptions of enrolled broadcasters are deleted,
+// and the store is updated with the resulting subscription IDs. Broadcasters that are not enrolled are left untouched.
+//
+// The broadcasters enrolled when Verify starts are verified. Enroll and Unenroll are not blocked while Verify talks
+// to Kick; the subscriptions Verify created for a broadcaster that was unenrolled in the meantime are deleted again.
+func (m *Manager) Verify(ctx context.Context, accessToken string) (*Report, error) {
+	m.verifying.Lock()
+	defer m.verifying.Unlock()
+
+	m.mu.Lock()
+	broadcasterUserIDs, err := m.config.Store.BroadcasterUserIDs(ctx)
+	m.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if len(broadcasterUserIDs) == 0 {
+		return &Report{}, nil
+	}
+
+	desired := make([]Subscription, 0, len(broadcasterUserIDs)*len(m.config.Events))
+	for _, broadcasterUserID := range broadcasterUserIDs {
+		desired = append(desired, m.subscriptions(broadcasterUserID)...)
+	}
+
+	report, reconcileErr := m.reconciler.Reconcile(ctx, accessToken, desired)
+	if report == nil {
+		return nil, reconcileErr
+	}
+
+	subscriptions := make(map[int][]ExistingSubscription, len(broadcasterUserIDs))
+	for _, existing := range slices.Concat(report.Unchanged, report.Created) {
+		subscriptions[existing.BroadcasterUserID] = append(subscriptions[existing.BroadcasterUserID], existing)
+	}
+
+	if err := m.save(ctx, accessToken, broadcasterUserIDs, subscriptions, report.Created); err != nil {
+		return report, err
+	}
+
+	return report, reconcileErr
+}
+
+// save stores the verified subscriptions of the broadcasters that are still enrolled and deletes the subscriptions
+// that were created for broadcasters unenrolled while Verify ran.
+func (m *Manager) save(ctx context.Context, accessToken string, broadcasterUserIDs []int, subscriptions map[int][]ExistingSubscription, created []ExistingSubscription) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	unenrolled := make(map[int]bool)
+	for _, broadcasterUserID := range broadcasterUserIDs {
+		_, enrolled, err := m.config.Store.Load(ctx, broadcasterUserID)
+		if err != nil {
+			return err
+		}
+		if !enrolled {
+			unenrolled[broadcasterUserID] = true
+			continue
+		}
+		if err := m.config.Store.Save(ctx, broadcasterUserID, subscriptions[broadcasterUserID]); err != nil {
+			return err
+		}
+	}
+
+	var orphaned []string
+	for _, subscription := range created {
+		if unenrolled[subscription.BroadcasterUserID] {
+			orphaned = append(orphaned, subscription.ID)
+		}
+	}
+	if len(orphaned) > 0 {
+		return m.config.EventsSubscription.DeleteEventSubscriptions(ctx, accessToken, orphaned)
+	}
+
+	return nil
+}
+
+// Run verifies the enrollments immediately and then every VerifyInterval until ctx is done.
+// accessToken is called before every verification so an expiring app access token can be refreshed.
+func (m *Manager) Run(ctx context.Context, accessToken func(ctx context.Context) (string, error)) error {
+	ticker := time.NewTicker(m.config.VerifyInterval)
+	defer ticker.Stop()
+
+	for {
+		m.verify(ctx, accessToken)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *Manager) verify(ctx context.Context, accessToken func(ctx context.Context) (string, error)) {
+	token, err := accessToken(ctx)
+	if err != nil {
+		m.logger.ErrorContext(ctx, "kick: could not get access token to verify subscriptions", slog.Any("error", err))
+		return
+	}
+
+	report, err := m.Verify(ctx, token)
+	if err != nil {
+		m.logger.ErrorContext(ctx, "kick: subscription verification failed", slog.Any("error", err))
+	}
+	if report != nil {
+		m.logger.InfoContext(ctx, "kick: subscriptions verified",
+			slog.Int("unchanged", len(report.Unchanged)),
+			slog.Int("created", len(report.Created)),
+			slog.Int("deleted", len(report.Deleted)),
+			slog.Int("failed", len(report.Failed)),
+		)
+	}
+}
+
+// subscriptions returns the standard event set for the broadcaster.
+func (m *Manager) subscriptions(broadcasterUserID int) []Subscription {
+	subscriptions := make([]Subscription, len(m.config.Events))
+	for i, event := range m.config.Events {
+		subscriptions[i] = normalize(Subscription{
+			BroadcasterUserID: broadcasterUserID,
+			Event:             event.Type,
+			Version:           event.Version,
+		})
+	}
+	return subscriptions
+}
@@ -0,0 +1,290 @@
+package kicksubscription
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/henrikah/kick-go-sdk/v2/enums/kickwebhookenum"
+	"github.com/henrikah/kick-go-sdk/v2/internal/ratelimit"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickcontracts"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+)
+
+const (
+	// ActionCreate marks a failed create in a SubscriptionChangeError.
+	ActionCreate = "create"
+	// ActionDelete marks a failed delete in a SubscriptionChangeError.
+	ActionDelete = "delete"
+
+	defaultVersion = 1
+)
+
+// Subscription is a webhook event subscription of a broadcaster.
+type Subscription struct {
+	BroadcasterUserID int
+	Event             kickwebhookenum.WebhookType
+	// Version of the event payload. Defaults to 1 when zero.
+	Version int
+}
+
+// ExistingSubscription is a subscription together with its subscription ID.
+type ExistingSubscription struct {
+	Subscription
+	ID string
+}
+
+// ReconcilerConfig configures a Reconciler.
+type ReconcilerConfig struct {
+	// EventsSubscription is the service used to read, create and delete subscriptions.
+	EventsSubscription kickcontracts.EventsSubscription
+
+	// DryRun computes the plan without creating or deleting anything.
+	DryRun bool
+
+	// PruneUnlistedBroadcasters deletes the subscriptions of broadcasters that are not in the desired set.
+	// By default only the broadcasters in the desired set are managed and all others are left untouched.
+	PruneUnlistedBroadcasters bool
+
+	// CreateInterval is the minimum time between two create requests. Creates are not paced when zero.
+	CreateInterval time.Duration
+}
+
+// Report describes the plan of a reconciliation and, unless it was a dry run, its outcome.
+type Report struct {
+	DryRun bool
+
+	// Unchanged are the existing subscriptions that are desired and kept.
+	Unchanged []ExistingSubscription
+	// ToCreate are the desired subscriptions that do not exist.
+	ToCreate []Subscription
+	// ToDelete are the existing subscriptions that are not desired or duplicate another subscription.
+	ToDelete []ExistingSubscription
+
+	// Created are the subscriptions that were created.
+	Created []ExistingSubscription
+	// Deleted are the subscriptions that were deleted.
+	Deleted []ExistingSubscription
+	// Failed are the creates and deletes that failed.
+	Failed []kickerrors.SubscriptionChangeError
+}
+
+// Reconciler brings the event subscriptions of an app or user in line with a desired set.
+type Reconciler struct {
+	config  ReconcilerConfig
+	limiter *ratelimit.Limiter
+}
+
+// NewReconciler creates a new Reconciler with the provided configuration.
+func NewReconciler(config ReconcilerConfig) (*Reconciler, error) {
+	if err := kickerrors.ValidateNotNil("EventsSubscription", config.EventsSubscription); err != nil {
+		return nil, err
+	}
+
+	return &Reconciler{
+		config:  config,
+		limiter: ratelimit.New(config.CreateInterval),
+	}, nil
+}
+
+// Reconcile reads the current subscriptions, computes the difference with desired and applies it.
+//
+// The report is returned whenever the current subscriptions could be read. When some creates or deletes fail,
+// including events that Kick rejected individually, the report is returned together with a *kickerrors.ReconcileError.
+func (r *Reconciler) Reconcile(ctx context.Context, accessToken string, desired []Subscription) (*Report, error) {
+	for _, subscription := range desired {
+		if err := kickerrors.ValidateBroadcasterUserID(subscription.BroadcasterUserID); err != nil {
+			return nil, err
+		}
+		if err := kickerrors.ValidateNotEmpty("Event", string(subscription.Event)); err != nil {
+			return nil, err
+		}
+	}
+
+	current, err := r.config.EventsSubscription.GetEventSubscriptions(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	report := r.plan(current.Data, desired)
+	if r.config.DryRun {
+		return report, nil
+	}
+
+	r.applyDeletes(ctx, accessToken, report)
+	r.applyCreates(ctx, accessToken, report)
+
+	if len(report.Failed) > 0 {
+		return report, kickerrors.SetReconcileError(len(report.ToCreate)+len(report.ToDelete), report.Failed)
+	}
+
+	return report, nil
+}
+
+// plan computes which subscriptions to keep, create and delete. The oldest of duplicate subscriptions is kept.
+func (r *Reconciler) plan(current []kickapitypes.EventSubscriptionData, desired []Subscription) *Report {
+	report := &Report{DryRun: r.config.DryRun}
+
+	wanted := make(map[Subscription]bool, len(desired))
+	managed := make(map[int]bool)
+	for _, subscription := range desired {
+		wanted[normalize(subscription)] = true
+		managed[subscription.BroadcasterUserID] = true
+	}
+
+	kept := make(map[Subscription]bool, len(current))
+	for _, data := range oldestFirst(current) {
+		existing := fromData(data)
+		if !managed[existing.BroadcasterUserID] && !r.config.PruneUnlistedBroadcasters {
+			continue
+		}
+
+		if wanted[existing.Subscription] && !kept[existing.Subscription] {
+			kept[existing.Subscription] = true
+			report.Unchanged = append(report.Unchanged, existing)
+			continue
+		}
+
+		report.ToDelete = append(report.ToDelete, existing)
+	}
+
+	seen := make(map[Subscription]bool, len(desired))
+	for _, subscription := range desired {
+		subscription = normalize(subscription)
+		if kept[subscription] || seen[subscription] {
+			continue
+		}
+		seen[subscription] = true
+		report.ToCreate = append(report.ToCreate, subscription)
+	}
+
+	return report
+}
+
+func (r *Reconciler) applyDeletes(ctx context.Context, accessToken string, report *Report) {
+	if len(report.ToDelete) == 0 {
+		return
+	}
+
+	subscriptionIDs := make([]string, len(report.ToDelete))
+	for i, existing := range report.ToDelete {
+		subscriptionIDs[i] = existing.ID
+	}
+
+	if err := r.config.EventsSubscription.DeleteEventSubscriptions(ctx, accessToken, subscriptionIDs); err != nil {
+		for _, existing := range report.ToDelete {
+			report.Failed = append(report.Failed, changeError(ActionDelete, existing.Subscription, err.Error(), err))
+		}
+		return
+	}
+
+	report.Deleted = append(report.Deleted, report.ToDelete...)
+}
+
+// applyCreates creates the missing subscriptions with one request per broadcaster.
+func (r *Reconciler) applyCreates(ctx context.Context, accessToken string, report *Report) {
+	byBroadcaster := make(map[int][]Subscription)
+	for _, subscription := range report.ToCreate {
+		byBroadcaster[subscription.BroadcasterUserID] = append(byBroadcaster[subscription.BroadcasterUserID], subscription)
+	}
+
+	broadcasterUserIDs := make([]int, 0, len(byBroadcaster))
+	for broadcasterUserID := range byBroadcaster {
+		broadcasterUserIDs = append(broadcasterUserIDs, broadcasterUserID)
+	}
+	slices.Sort(broadcasterUserIDs)
+
+	for _, broadcasterUserID := range broadcasterUserIDs {
+		created, failed := create(ctx, r.config.EventsSubscription, r.limiter, accessToken, broadcasterUserID, byBroadcaster[broadcasterUserID])
+		report.Created = append(report.Created, created...)
+		report.Failed = append(report.Failed, failed...)
+	}
+}
+
+// create subscribes a single broadcaster to the given normalized subscriptions in one request once the limiter
+// allows it. Events that Kick rejects individually are returned as failed together with the reason Kick gave.
+func create(ctx context.Context, service kickcontracts.EventsSubscription, limiter *ratelimit.Limiter, accessToken string, broadcasterUserID int, subscriptions []Subscription) ([]ExistingSubscription, []kickerrors.SubscriptionChangeError) {
+	events := make([]kickapitypes.EventObject, len(subscriptions))
+	for i, subscription := range subscriptions {
+		events[i] = kickapitypes.EventObject{
+			Name:    string(subscription.Event),
+			Version: subscription.Version,
+		}
+	}
+
+	var response *kickapitypes.CreateEventSubscriptionsResponse
+	err := limiter.Wait(ctx)
+	if err == nil {
+		response, err = service.CreateVersionedEventSubscriptions(ctx, accessToken, &broadcasterUserID, events)
+	}
+
+	partialErr := kickerrors.IsPartialSubscriptionError(err)
+	if err != nil && partialErr == nil {
+		failed := make([]kickerrors.SubscriptionChangeError, len(subscriptions))
+		for i, subscription := range subscriptions {
+			failed[i] = changeError(ActionCreate, subscription, err.Error(), err)
+		}
+		return nil, failed
+	}
+
+	if partialErr == nil {
+		partialErr = &kickerrors.PartialSubscriptionError{}
+		for _, data := range response.Data {
+			partialErr.Subscribed = append(partialErr.Subscribed, kickerrors.SubscribedEvent{Event: data.Name, Version: data.Version, SubscriptionID: data.SubscriptionID})
+		}
+	}
+
+	var created []ExistingSubscription
+	for _, subscribed := range partialErr.Subscribed {
+		created = append(created, ExistingSubscription{
+			Subscription: normalize(Subscription{BroadcasterUserID: broadcasterUserID, Event: kickwebhookenum.WebhookType(subscribed.Event), Version: subscribed.Version}),
+			ID:           subscribed.SubscriptionID,
+		})
+	}
+
+	var failed []kickerrors.SubscriptionChangeError
+	for _, failure := range partialErr.Failed {
+		subscription := normalize(Subscription{BroadcasterUserID: broadcasterUserID, Event: kickwebhookenum.WebhookType(failure.Event), Version: failure.Version})
+		failed = append(failed, changeError(ActionCreate, subscription, failure.Reason, nil))
+	}
+
+	return created, failed
+}
+
+// oldestFirst returns a copy of the subscriptions ordered by creation time.
+func oldestFirst(current []kickapitypes.EventSubscriptionData) []kickapitypes.EventSubscriptionData {
+	current = slices.Clone(current)
+	slices.SortStableFunc(current, func(a, b kickapitypes.EventSubscriptionData) int {
+		return a.CreatedAt.Time().Compare(b.CreatedAt.Time())
+	})
+	return current
+}
+
+func normalize(subscription Subscription) Subscription {
+	subscription.Version = cmp.Or(subscription.Version, defaultVersion)
+	return subscription
+}
+
+func fromData(data kickapitypes.EventSubscriptionData) ExistingSubscription {
+	return ExistingSubscription{
+		Subscription: Subscription{
+			BroadcasterUserID: data.BroadcasterUserID,
+			Event:             kickwebhookenum.WebhookType(data.Event),
+			Version:           cmp.Or(data.Version, defaultVersion),
+		},
+		ID: data.ID,
+	}
+}
+
+func changeError(action string, subscription Subscription, reason string, err error) kickerrors.SubscriptionChangeError {
+	return kickerrors.SubscriptionChangeError{
+		Action:            action,
+		BroadcasterUserID: subscription.BroadcasterUserID,
+		Event:             string(subscription.Event),
+		Version:           subscription.Version,
+		Reason:            reason,
+		Err:               err,
+	}
+}
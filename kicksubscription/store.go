@@ -0,0 +1,69 @@
+package kicksubscription
+
+import (
+	"context"
+	"slices"
+	"sync"
+)
+
+// Store persists which subscriptions each enrolled broadcaster has. Implementations must be safe for concurrent use.
+type Store interface {
+	// Load returns the subscriptions of the broadcaster and whether the broadcaster is enrolled.
+	Load(ctx context.Context, broadcasterUserID int) ([]ExistingSubscription, bool, error)
+
+	// Save enrolls the broadcaster with the given subscriptions, replacing any stored before.
+	Save(ctx context.Context, broadcasterUserID int, subscriptions []ExistingSubscription) error
+
+	// Delete unenrolls the broadcaster.
+	Delete(ctx context.Context, broadcasterUserID int) error
+
+	// BroadcasterUserIDs returns every enrolled broadcaster.
+	BroadcasterUserIDs(ctx context.Context) ([]int, error)
+}
+
+// MemoryStore is a Store that keeps the enrollments in memory.
+type MemoryStore struct {
+	mu            sync.RWMutex
+	subscriptions map[int][]ExistingSubscription
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+// NewMemoryStore creates an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		subscriptions: make(map[int][]ExistingSubscription),
+	}
+}
+
+func (s *MemoryStore) Load(_ context.Context, broadcasterUserID int) ([]ExistingSubscription, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	subscriptions, ok := s.subscriptions[broadcasterUserID]
+	return slices.Clone(subscriptions), ok, nil
+}
+
+func (s *MemoryStore) Save(_ context.Context, broadcasterUserID int, subscriptions []ExistingSubscription) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.subscriptions[broadcasterUserID] = slices.Clone(subscriptions)
+	return nil
+}
+
+func (s *MemoryStore) Delete(_ context.Context, broadcasterUserID int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.subscriptions, broadcasterUserID)
+	return nil
+}
+
+func (s *MemoryStore) BroadcasterUserIDs(_ context.Context) ([]int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	broadcasterUserIDs := make([]int, 0, len(s.subscriptions))
+	for broadcasterUserID := range s.subscriptions {
+		broadcasterUserIDs = append(broadcasterUserIDs, broadcasterUserID)
+	}
+	slices.Sort(broadcasterUserIDs)
+	return broadcasterUserIDs, nil
+}
@@ -0,0 +1,7 @@
+// Package kicktelemetry defines the tracing and metrics hooks the SDK calls around API requests and webhook deliveries.
+//
+// The SDK itself does not depend on any telemetry library. A Tracer and a MetricsRecorder can be set on
+// APIClientConfig, OAuthClientConfig and WebhookClientConfig; when neither is set the SDK skips every
+// telemetry call, so disabled telemetry costs nothing. The kickotel module implements both interfaces
+// on top of OpenTelemetry.
+package kicktelemetry
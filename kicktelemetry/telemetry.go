@@ -0,0 +1,80 @@
+package kicktelemetry
+
+import (
+	"context"
+	"time"
+)
+
+// RequestInfo describes an API request before it is sent.
+type RequestInfo struct {
+	// Operation is the service method that made the request, e.g. "Chat.SendChatMessageAsBot".
+	Operation string
+	// Method is the HTTP method of the request.
+	Method string
+	// Endpoint is the endpoint template of the request without hostname or query, e.g. "public/v1/chat/{message_id}".
+	Endpoint string
+}
+
+// RequestResult describes the outcome of an API request.
+type RequestResult struct {
+	// StatusCode is the HTTP status code of the response, 0 when no response was received.
+	StatusCode int
+	// RetryCount is the number of times the request was retried.
+	RetryCount int
+	// Duration is the time from sending the request until the response was handled.
+	Duration time.Duration
+	// Err is the error returned to the caller, nil on success.
+	Err error
+}
+
+// WebhookInfo describes an incoming webhook before it is verified.
+type WebhookInfo struct {
+	// EventType is the value of the Kick-Event-Type header, e.g. "chat.message.sent".
+	EventType string
+	// EventVersion is the value of the Kick-Event-Version header.
+	EventVersion string
+	// MessageID is the value of the Kick-Event-Message-Id header.
+	MessageID string
+	// SubscriptionID is the value of the Kick-Event-Subscription-Id header.
+	SubscriptionID string
+}
+
+// WebhookResult describes the outcome of an incoming webhook.
+type WebhookResult struct {
+	// Verified is true when the signature of the webhook was valid.
+	Verified bool
+	// Duration is the time from receiving the webhook until the handler returned.
+	Duration time.Duration
+	// Err is the error passed to the webhook error callback, nil when the webhook was handled.
+	Err error
+}
+
+// RequestSpan is an in-flight span of an API request.
+type RequestSpan interface {
+	// End finishes the span with the outcome of the request.
+	End(result RequestResult)
+}
+
+// WebhookSpan is an in-flight span of an incoming webhook.
+type WebhookSpan interface {
+	// End finishes the span with the outcome of the webhook.
+	End(result WebhookResult)
+}
+
+// Tracer starts spans for API requests and incoming webhooks.
+type Tracer interface {
+	// StartRequestSpan starts a client span for an API request. The returned context is used for the HTTP request.
+	StartRequestSpan(ctx context.Context, info RequestInfo) (context.Context, RequestSpan)
+
+	// StartWebhookSpan starts a server span for an incoming webhook. The returned context is passed to the webhook handler.
+	StartWebhookSpan(ctx context.Context, info WebhookInfo) (context.Context, WebhookSpan)
+}
+
+// MetricsRecorder records the outcome of API requests and incoming webhooks.
+type MetricsRecorder interface {
+	// RecordRequest records the duration and outcome of an API request.
+	RecordRequest(ctx context.Context, info RequestInfo, result RequestResult)
+
+	// RecordWebhook records an incoming webhook by event type and outcome.
+	RecordWebhook(ctx context.Context, info WebhookInfo, result WebhookResult)
+}
@@ -0,0 +1,6 @@
+// Package kicktime provides the timestamp type used by the Kick API and webhook data types.
+//
+// Kick does not use a single timestamp layout across its endpoints and webhooks. KickTime accepts
+// every layout Kick has been observed to emit, treats empty strings and null as the zero time and
+// marshals back to the exact value it was decoded from.
+package kicktime
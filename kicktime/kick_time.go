@@ -0,0 +1,150 @@
+package kicktime
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// layouts lists the accepted layouts in the order they are attempted. Layouts without a zone are read as UTC.
+var layouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999Z0700",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999Z0700",
+	"2006-01-02 15:04:05.999999999 -0700 MST",
+	"2006-01-02 15:04:05.999999999",
+	time.DateOnly,
+}
+
+// unixMillisecondsThreshold separates Unix timestamps in seconds from timestamps in milliseconds.
+const unixMillisecondsThreshold = 1e11
+
+// KickTime is a timestamp of the Kick API or a webhook. It reads every layout Kick emits, including Unix seconds
+// and milliseconds, and writes a decoded value back exactly as it was received. Use Equal or IsZero to compare
+// timestamps, == also compares the received JSON and the time zone.
+type KickTime struct {
+	time time.Time
+
+	// raw is the JSON the value was decoded from, empty when it was created from a time.Time.
+	raw string
+}
+
+// New creates a KickTime from a time.Time. It marshals as RFC 3339 with nanosecond precision.
+func New(t time.Time) KickTime {
+	return KickTime{time: t}
+}
+
+// Parse parses a timestamp in any of the layouts Kick emits. An empty string results in the zero time.
+func Parse(value string) (KickTime, error) {
+	if value == "" {
+		return KickTime{raw: `""`}, nil
+	}
+
+	parsed, err := parse(value)
+	if err != nil {
+		return KickTime{}, err
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return KickTime{}, err
+	}
+
+	return KickTime{time: parsed, raw: string(raw)}, nil
+}
+
+// Time returns the timestamp as a time.Time. Empty and null values return the zero time.
+func (t KickTime) Time() time.Time {
+	return t.time
+}
+
+// IsZero reports whether the timestamp was empty, null or missing.
+func (t KickTime) IsZero() bool {
+	return t.time.IsZero()
+}
+
+// Equal reports whether both timestamps are the same instant, regardless of how they were received.
+func (t KickTime) Equal(other KickTime) bool {
+	return t.time.Equal(other.time)
+}
+
+// String returns the timestamp formatted as RFC 3339, or an empty string for the zero time.
+func (t KickTime) String() string {
+	if t.time.IsZero() {
+		return ""
+	}
+	return t.time.Format(time.RFC3339Nano)
+}
+
+func (t KickTime) MarshalJSON() ([]byte, error) {
+	if t.raw != "" {
+		return []byte(t.raw), nil
+	}
+	if t.time.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.time.Format(time.RFC3339Nano))
+}
+
+func (t *KickTime) UnmarshalJSON(data []byte) error {
+	raw := string(bytes.TrimSpace(data))
+
+	if raw == "null" {
+		*t = KickTime{raw: raw}
+		return nil
+	}
+
+	if len(raw) > 0 && raw[0] != '"' {
+		seconds, err := strconv.ParseFloat(string(raw), 64)
+		if err != nil {
+			return fmt.Errorf("kicktime: cannot parse %s as a timestamp", raw)
+		}
+		*t = KickTime{time: fromUnix(seconds), raw: raw}
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal([]byte(raw), &value); err != nil {
+		return err
+	}
+
+	if value == "" {
+		*t = KickTime{raw: raw}
+		return nil
+	}
+
+	parsed, err := parse(value)
+	if err != nil {
+		return err
+	}
+
+	*t = KickTime{time: parsed, raw: raw}
+	return nil
+}
+
+func parse(value string) (time.Time, error) {
+	for _, layout := range layouts {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			return parsed, nil
+		}
+	}
+
+	if seconds, err := strconv.ParseFloat(value, 64); err == nil {
+		return fromUnix(seconds), nil
+	}
+
+	return time.Time{}, fmt.Errorf("kicktime: cannot parse %q as a timestamp", value)
+}
+
+func fromUnix(value float64) time.Time {
+	if value >= unixMillisecondsThreshold {
+		return time.UnixMilli(int64(value)).UTC()
+	}
+
+	seconds := int64(value)
+	return time.Unix(seconds, int64((value-float64(seconds))*1e9)).UTC()
+}
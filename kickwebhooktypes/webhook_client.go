@@ -0,0 +1,26 @@
+package kickwebhooktypes
+
+import (
+	"log/slog"
+
+	"github.com/henrikah/kick-go-sdk/v2/kicktelemetry"
+)
+
+type WebhookClientConfig struct {
+	// PublicKey is the PEM encoded public key used to verify webhook signatures.
+	PublicKey string
+
+	// OnError is optional and will be called if a webhook handler returns an error.
+	// Defaults to logging the error through Logger.
+	OnError func(error)
+
+	// Logger receives verification failures and dispatch outcomes with signatures redacted.
+	// Defaults to slog.Default() when nil.
+	Logger *slog.Logger
+
+	// Tracer starts a server span for every incoming webhook. Telemetry is skipped when nil.
+	Tracer kicktelemetry.Tracer
+
+	// Metrics records every incoming webhook by event type and outcome. Telemetry is skipped when nil.
+	Metrics kicktelemetry.MetricsRecorder
+}
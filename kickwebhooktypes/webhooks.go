@@ -1,17 +1,20 @@
 package kickwebhooktypes
 
-import "github.com/henrikah/kick-go-sdk/v2/enums/kickchannelrewardstatus"
+import (
+	"github.com/henrikah/kick-go-sdk/v2/enums/kickchannelrewardstatus"
+	"github.com/henrikah/kick-go-sdk/v2/kicktime"
+)
 
 /** Parent structs **/
 
 type ChatMessageSent struct {
-	MessageID   string    `json:"message_id"`
-	RepliesTo   RepliesTo `json:"replies_to"`
-	Broadcaster User      `json:"broadcaster"`
-	Sender      User      `json:"sender"`
-	Content     string    `json:"content"`
-	Emotes      []Emote   `json:"emotes"`
-	CreatedAt   string    `json:"created_at"`
+	MessageID   string            `json:"message_id"`
+	RepliesTo   RepliesTo         `json:"replies_to"`
+	Broadcaster User              `json:"broadcaster"`
+	Sender      User              `json:"sender"`
+	Content     string            `json:"content"`
+	Emotes      []Emote           `json:"emotes"`
+	CreatedAt   kicktime.KickTime `json:"created_at"`
 }
 
 type ChannelFollowed struct {
@@ -20,35 +23,35 @@ type ChannelFollowed struct {
 }
 
 type ChannelSubscriptionRenewal struct {
-	Broadcaster User   `json:"broadcaster"`
-	Subscriber  User   `json:"subscriber"`
-	Duration    int    `json:"duration"`
-	CreatedAt   string `json:"created_at"`
-	ExpiresAt   string `json:"expires_at"`
+	Broadcaster User              `json:"broadcaster"`
+	Subscriber  User              `json:"subscriber"`
+	Duration    int               `json:"duration"`
+	CreatedAt   kicktime.KickTime `json:"created_at"`
+	ExpiresAt   kicktime.KickTime `json:"expires_at"`
 }
 
 type ChannelSubscriptionGifts struct {
-	Broadcaster User   `json:"broadcaster"`
-	Gifter      User   `json:"gifter"`
-	Giftees     []User `json:"giftees"`
-	CreatedAt   string `json:"created_at"`
-	ExpiresAt   string `json:"expires_at"`
+	Broadcaster User              `json:"broadcaster"`
+	Gifter      User              `json:"gifter"`
+	Giftees     []User            `json:"giftees"`
+	CreatedAt   kicktime.KickTime `json:"created_at"`
+	ExpiresAt   kicktime.KickTime `json:"expires_at"`
 }
 
 type ChannelSubscriptionNew struct {
-	Broadcaster User   `json:"broadcaster"`
-	Subscriber  User   `json:"subscriber"`
-	Duration    int    `json:"duration"`
-	CreatedAt   string `json:"created_at"`
-	ExpiresAt   string `json:"expires_at"`
+	Broadcaster User              `json:"broadcaster"`
+	Subscriber  User              `json:"subscriber"`
+	Duration    int               `json:"duration"`
+	CreatedAt   kicktime.KickTime `json:"created_at"`
+	ExpiresAt   kicktime.KickTime `json:"expires_at"`
 }
 
 type LivestreamStatusUpdated struct {
-	Broadcaster User    `json:"broadcaster"`
-	IsLive      bool    `json:"is_live"`
-	Title       string  `json:"title"`
-	StartedAt   string  `json:"started_at"`
-	EndedAt     *string `json:"ended_at"`
+	Broadcaster User              `json:"broadcaster"`
+	IsLive      bool              `json:"is_live"`
+	Title       string            `json:"title"`
+	StartedAt   kicktime.KickTime `json:"started_at"`
+	EndedAt     kicktime.KickTime `json:"ended_at"`
 }
 
 type LivestreamMetadataUpdated struct {
@@ -64,10 +67,10 @@ type ModerationBanned struct {
 }
 
 type KicksGifted struct {
-	Broadcaster User   `json:"broadcaster"`
-	Sender      User   `json:"sender"`
-	Gift        Gift   `json:"gift"`
-	CreatedAt   string `json:"created_at"`
+	Broadcaster User              `json:"broadcaster"`
+	Sender      User              `json:"sender"`
+	Gift        Gift              `json:"gift"`
+	CreatedAt   kicktime.KickTime `json:"created_at"`
 }
 
 /** Body structs **/
@@ -123,9 +126,9 @@ type Category struct {
 }
 
 type ModerationBannedMetadata struct {
-	Reason    string `json:"reason"`
-	CreatedAt string `json:"created_at"`
-	ExpiresAt string `json:"expires_at"`
+	Reason    string            `json:"reason"`
+	CreatedAt kicktime.KickTime `json:"created_at"`
+	ExpiresAt kicktime.KickTime `json:"expires_at"`
 }
 
 type Gift struct {
@@ -141,7 +144,7 @@ type ChannelRewardRedemptionUpdated struct {
 	ID          string                                      `json:"id"`
 	UserInput   string                                      `json:"user_input"`
 	Status      kickchannelrewardstatus.ChannelRewardStatus `json:"status"`
-	RedeemedAt  string                                      `json:"redeemed_at"`
+	RedeemedAt  kicktime.KickTime                           `json:"redeemed_at"`
 	Reward      Reward                                      `json:"reward"`
 	Redeemer    Redeemer                                    `json:"redeemer"`
 	Broadcaster Broadcaster                                 `json:"broadcaster"`
@@ -23,6 +23,8 @@ func newLivestreamService(client *apiClient) kickcontracts.Livestream {
 }
 
 func (c *livestreamClient) SearchLivestreams(ctx context.Context, accessToken string, filters kickfilters.LivestreamsFilter) (*kickapitypes.LivestreamResponse, error) {
+	ctx = c.client.requester.WithOperation(ctx, "Livestream.SearchLivestreams")
+
 	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
 		return nil, err
 	}
@@ -50,6 +52,8 @@ func (c *livestreamClient) SearchLivestreams(ctx context.Context, accessToken st
 	return &livestreamResponse, nil
 }
 func (c *livestreamClient) GetCurrentUserLivestream(ctx context.Context, accessToken string) (*kickapitypes.LivestreamResponse, error) {
+	ctx = c.client.requester.WithOperation(ctx, "Livestream.GetCurrentUserLivestream")
+
 	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
 		return nil, err
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
 	"github.com/henrikah/kick-go-sdk/v2/kickcontracts"
 	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/kickmoderation"
 )
 
 type moderationClient struct {
@@ -19,11 +20,12 @@ func newModerationService(client *apiClient) kickcontracts.Moderation {
 		client: client,
 	}
 }
-func (c *moderationClient) TimeOutUser(ctx context.Context, accessToken string, broadcasterUserID int, userID int, durationInSeconds int, reason *string) (*kickapitypes.ModerationResponse, error) {
+func (c *moderationClient) TimeOutUser(ctx context.Context, accessToken string, broadcasterUserID int, userID int, durationInMinutes int, reason *string) (*kickapitypes.ModerationResponse, error) {
+	ctx = c.client.requester.WithOperation(ctx, "Moderation.TimeOutUser")
 
 	timeoutRequest := kickapitypes.ModerationRequest{
 		BroadcasterUserID: broadcasterUserID,
-		Duration:          &durationInSeconds,
+		Duration:          &durationInMinutes,
 		Reason:            reason,
 		UserID:            userID,
 	}
@@ -32,6 +34,7 @@ func (c *moderationClient) TimeOutUser(ctx context.Context, accessToken string,
 }
 
 func (c *moderationClient) BanUser(ctx context.Context, accessToken string, broadcasterUserID int, userID int, reason *string) (*kickapitypes.ModerationResponse, error) {
+	ctx = c.client.requester.WithOperation(ctx, "Moderation.BanUser")
 
 	banRequest := kickapitypes.ModerationRequest{
 		BroadcasterUserID: broadcasterUserID,
@@ -43,6 +46,21 @@ func (c *moderationClient) BanUser(ctx context.Context, accessToken string, broa
 	return c.moderateUser(ctx, accessToken, banRequest)
 }
 
+func (c *moderationClient) Moderate(ctx context.Context, accessToken string, request kickmoderation.Request) (*kickapitypes.ModerationResponse, error) {
+	ctx = c.client.requester.WithOperation(ctx, "Moderation.Moderate")
+
+	if err := kickerrors.ValidateNotNil("request", request); err != nil {
+		return nil, err
+	}
+
+	moderationRequest, err := request.ToRequest()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.moderateUser(ctx, accessToken, moderationRequest)
+}
+
 func (c *moderationClient) moderateUser(ctx context.Context, accessToken string, moderationRequest kickapitypes.ModerationRequest) (*kickapitypes.ModerationResponse, error) {
 	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
 		return nil, err
@@ -69,6 +87,8 @@ func (c *moderationClient) moderateUser(ctx context.Context, accessToken string,
 }
 
 func (c *moderationClient) UnbanUser(ctx context.Context, accessToken string, broadcasterUserID int, userID int) (*kickapitypes.ModerationResponse, error) {
+	ctx = c.client.requester.WithOperation(ctx, "Moderation.UnbanUser")
+
 	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
 		return nil, err
 	}
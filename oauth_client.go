@@ -41,7 +41,13 @@ func NewOAuthClient(clientConfig kickoauthtypes.OAuthClientConfig) (kickcontract
 		return nil, err
 	}
 
-	requester, err := transport.NewRequester(clientConfig.HTTPClient)
+	requester, err := transport.NewRequester(
+		clientConfig.HTTPClient,
+		transport.WithLogger(clientConfig.Logger),
+		transport.WithTracer(clientConfig.Tracer),
+		transport.WithMetrics(clientConfig.Metrics),
+		transport.WithInterceptors(clientConfig.Interceptors...),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -94,6 +100,8 @@ func (c *oAuthClient) InitiateAuthorization(redirectURI string, state string, sc
 }
 
 func (c *oAuthClient) ExchangeAuthorizationCode(ctx context.Context, redirectURI string, authorizationCode string, codeVerifier string) (*kickoauthtypes.CodeExchangeResponse, error) {
+	ctx = c.requester.WithOperation(ctx, "OAuth.ExchangeAuthorizationCode")
+
 	if err := kickerrors.ValidateNotEmpty("redirectURI", redirectURI); err != nil {
 		return nil, err
 	}
@@ -123,6 +131,8 @@ func (c *oAuthClient) ExchangeAuthorizationCode(ctx context.Context, redirectURI
 }
 
 func (c *oAuthClient) GetAppAccessToken(ctx context.Context) (*kickoauthtypes.AppAccessTokenResponse, error) {
+	ctx = c.requester.WithOperation(ctx, "OAuth.GetAppAccessToken")
+
 	appAccessTokenData := url.Values{}
 	appAccessTokenData.Set("client_id", c.clientID)
 	appAccessTokenData.Set("client_secret", c.clientSecret)
@@ -138,12 +148,16 @@ func (c *oAuthClient) GetAppAccessToken(ctx context.Context) (*kickoauthtypes.Ap
 }
 
 func (c *oAuthClient) RevokeAccessToken(ctx context.Context, accessToken string) error {
+	ctx = c.requester.WithOperation(ctx, "OAuth.RevokeAccessToken")
+
 	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
 		return err
 	}
 	return c.revokeToken(ctx, accessToken, "access_token")
 }
 func (c *oAuthClient) RevokeRefreshToken(ctx context.Context, refreshToken string) error {
+	ctx = c.requester.WithOperation(ctx, "OAuth.RevokeRefreshToken")
+
 	if err := kickerrors.ValidateNotEmpty("refreshToken", refreshToken); err != nil {
 		return err
 	}
@@ -162,6 +176,8 @@ func (c *oAuthClient) revokeToken(ctx context.Context, token string, tokenType s
 }
 
 func (c *oAuthClient) TokenIntrospect(ctx context.Context, accessToken string) (*kickoauthtypes.TokenIntrospect, error) {
+	ctx = c.requester.WithOperation(ctx, "OAuth.TokenIntrospect")
+
 	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
 		return nil, err
 	}
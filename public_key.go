@@ -18,6 +18,8 @@ func newPublicKeyService(client *apiClient) kickcontracts.PublicKey {
 	}
 }
 func (c *publicKeyClient) GetWebhookPublicKey(ctx context.Context) (*kickapitypes.PublicKeyResponse, error) {
+	ctx = c.client.requester.WithOperation(ctx, "PublicKey.GetWebhookPublicKey")
+
 	var publicKeyResponse kickapitypes.PublicKeyResponse
 
 	if err := c.client.requester.MakeGetRequest(ctx, endpoints.ViewWebhookPublicKeyURL(), nil, &publicKeyResponse); err != nil {
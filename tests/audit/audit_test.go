@@ -0,0 +1,213 @@
+package kick_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/henrikah/kick-go-sdk/v2"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickaudit"
+	"github.com/henrikah/kick-go-sdk/v2/kickcontracts"
+	"github.com/henrikah/kick-go-sdk/v2/kickinterceptor"
+	"github.com/henrikah/kick-go-sdk/v2/kicktime"
+	"github.com/henrikah/kick-go-sdk/v2/kickwebhooktypes"
+	"github.com/henrikah/kick-go-sdk/v2/tests/mocks"
+)
+
+func newAuditedClient(t *testing.T, recorder *kickaudit.Recorder, failUserID int) kickcontracts.APIClient {
+	t.Helper()
+
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if req.Body != nil && req.Method != http.MethodGet {
+				var body kickapitypes.ModerationRequest
+				if err := json.NewDecoder(req.Body).Decode(&body); err == nil && body.UserID == failUserID {
+					return mocks.NewMockResponse(http.StatusBadRequest, `{"message":"user is a moderator"}`), nil
+				}
+			}
+			return mocks.NewMockResponse(http.StatusOK, `{"data":{},"message":"OK"}`), nil
+		},
+	}
+
+	client, err := kick.NewAPIClient(kickapitypes.APIClientConfig{
+		HTTPClient:   httpClient,
+		Interceptors: []kickinterceptor.Interceptor{recorder.Interceptor()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func bannedEvent(broadcasterUserID, moderatorUserID, userID int, at time.Time, expiresAt time.Time) kickwebhooktypes.ModerationBanned {
+	event := kickwebhooktypes.ModerationBanned{
+		Broadcaster: kickwebhooktypes.User{UserID: broadcasterUserID},
+		Moderator:   kickwebhooktypes.User{UserID: moderatorUserID, Username: "mod"},
+		BannedUser:  kickwebhooktypes.User{UserID: userID, Username: "spammer"},
+		Metadata:    kickwebhooktypes.ModerationBannedMetadata{Reason: "spam", CreatedAt: kicktime.New(at)},
+	}
+	if !expiresAt.IsZero() {
+		event.Metadata.ExpiresAt = kicktime.New(expiresAt)
+	}
+	return event
+}
+
+func Test_RecorderInterceptor_Success(t *testing.T) {
+	// Arrange
+	recorder, _ := kickaudit.NewRecorder(kickaudit.RecorderConfig{})
+	client := newAuditedClient(t, recorder, 13)
+	ctx := kickaudit.WithActor(t.Context(), kickaudit.Actor{UserID: 99, Username: "automod"})
+	reason := "spam"
+
+	// Act
+	_, banErr := client.Moderation().BanUser(ctx, "access-token", 1, 10, &reason)
+	_, timeoutErr := client.Moderation().TimeOutUser(ctx, "access-token", 1, 11, 5, nil)
+	_, unbanErr := client.Moderation().UnbanUser(ctx, "access-token", 1, 12)
+	deleteErr := client.Chat().DeleteChatMessage(kickaudit.WithBroadcaster(ctx, 1), "access-token", "message-1")
+	_, failedErr := client.Moderation().BanUser(ctx, "access-token", 1, 13, nil)
+	entries, err := recorder.Query(t.Context(), kickaudit.Filter{BroadcasterUserID: 1})
+
+	// Assert
+	if banErr != nil || timeoutErr != nil || unbanErr != nil || deleteErr != nil || failedErr == nil {
+		t.Fatalf("Unexpected call results %v %v %v %v %v", banErr, timeoutErr, unbanErr, deleteErr, failedErr)
+	}
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(entries) != 5 {
+		t.Fatalf("Expected 5 entries, got %+v", entries)
+	}
+
+	expected := []kickaudit.Action{kickaudit.ActionBan, kickaudit.ActionTimeout, kickaudit.ActionUnban, kickaudit.ActionDeleteMessage, kickaudit.ActionBan}
+	for i, entry := range entries {
+		if entry.Action != expected[i] || entry.Moderator.UserID != 99 {
+			t.Fatalf("Expected entry %d to be %s by the actor, got %+v", i, expected[i], entry)
+		}
+	}
+
+	if entries[0].Reason != "spam" || entries[0].Target.UserID != 10 || entries[0].BroadcasterUserID != 1 {
+		t.Fatalf("Expected the ban with reason, got %+v", entries[0])
+	}
+	if entries[1].ExpiresAt.Sub(entries[1].At) != 5*time.Minute {
+		t.Fatalf("Expected the timeout to expire after 5 minutes, got %+v", entries[1])
+	}
+	if entries[3].MessageID != "message-1" || entries[3].BroadcasterUserID != 1 {
+		t.Fatalf("Expected the deleted message ID, got %+v", entries[3])
+	}
+	if entries[4].Error == "" {
+		t.Fatalf("Expected the failed call to be recorded with its error, got %+v", entries[4])
+	}
+}
+
+func Test_RecorderQueryMergesWebhook_Success(t *testing.T) {
+	// Arrange
+	recorder, _ := kickaudit.NewRecorder(kickaudit.RecorderConfig{})
+	client := newAuditedClient(t, recorder, 0)
+
+	_, err := client.Moderation().TimeOutUser(t.Context(), "access-token", 1, 10, 10, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+
+	// Act
+	echoErr := recorder.RecordBan(t.Context(), bannedEvent(1, 50, 10, now, now.Add(10*time.Minute)))
+	manualErr := recorder.RecordBan(t.Context(), bannedEvent(1, 60, 11, now, time.Time{}))
+	byModerator, queryErr := recorder.Query(t.Context(), kickaudit.Filter{ModeratorUserID: 50})
+	all, _ := recorder.Query(t.Context(), kickaudit.Filter{BroadcasterUserID: 1})
+
+	// Assert
+	if echoErr != nil || manualErr != nil || queryErr != nil {
+		t.Fatalf("Expected no error, got %v %v %v", echoErr, manualErr, queryErr)
+	}
+
+	if len(all) != 2 {
+		t.Fatalf("Expected the webhook to merge into the API call, got %+v", all)
+	}
+
+	if len(byModerator) != 1 {
+		t.Fatalf("Expected 1 entry by the moderator, got %+v", byModerator)
+	}
+
+	merged := byModerator[0]
+	if !slices.Equal(merged.Sources, []kickaudit.Source{kickaudit.SourceAPI, kickaudit.SourceWebhook}) ||
+		merged.Action != kickaudit.ActionTimeout || merged.Target.Username != "spammer" || merged.Reason != "spam" {
+		t.Fatalf("Expected a merged timeout, got %+v", merged)
+	}
+
+	if all[1].Action != kickaudit.ActionBan || !slices.Equal(all[1].Sources, []kickaudit.Source{kickaudit.SourceWebhook}) {
+		t.Fatalf("Expected the manual ban from the webhook, got %+v", all[1])
+	}
+}
+
+func Test_FileStoreQuery_Success(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	store, err := kickaudit.NewFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	recorder, _ := kickaudit.NewRecorder(kickaudit.RecorderConfig{Store: store})
+	start := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+	for i, event := range []kickwebhooktypes.ModerationBanned{
+		bannedEvent(1, 50, 10, start, time.Time{}),
+		bannedEvent(1, 50, 11, start.Add(time.Hour), time.Time{}),
+		bannedEvent(2, 60, 10, start.Add(2*time.Hour), time.Time{}),
+	} {
+		if err := recorder.RecordBan(t.Context(), event); err != nil {
+			t.Fatalf("Expected event %d to be recorded, got %v", i, err)
+		}
+	}
+
+	// Act
+	reopened, err := kickaudit.NewFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reopened.Close()
+
+	byTarget, targetErr := reopened.Query(t.Context(), kickaudit.Filter{TargetUserID: 10})
+	inRange, rangeErr := reopened.Query(t.Context(), kickaudit.Filter{Since: start.Add(30 * time.Minute), Until: start.Add(2 * time.Hour)})
+
+	// Assert
+	if targetErr != nil || rangeErr != nil {
+		t.Fatalf("Expected no error, got %v and %v", targetErr, rangeErr)
+	}
+
+	if len(byTarget) != 2 || byTarget[0].BroadcasterUserID != 1 || byTarget[1].BroadcasterUserID != 2 {
+		t.Fatalf("Expected the bans of user 10 in both channels, got %+v", byTarget)
+	}
+
+	if len(inRange) != 1 || inRange[0].Target.UserID != 11 || !inRange[0].At.Equal(start.Add(time.Hour)) {
+		t.Fatalf("Expected the ban within the range, got %+v", inRange)
+	}
+}
+
+func Test_FileStoreCorruptLine_Error(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	if err := os.WriteFile(path, []byte("{\"action\":\"ban\"}\nnot json\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	store, err := kickaudit.NewFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+
+	// Act
+	_, err = store.Query(t.Context(), kickaudit.Filter{})
+
+	// Assert
+	if err == nil {
+		t.Fatal("Expected an error for the corrupt line")
+	}
+}
@@ -0,0 +1,316 @@
+package kick_test
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/henrikah/kick-go-sdk/v2"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickautomod"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/kickwebhooktypes"
+	"github.com/henrikah/kick-go-sdk/v2/tests/mocks"
+)
+
+func automodMessage(content string, badges ...string) kickwebhooktypes.ChatMessageSent {
+	identity := &kickwebhooktypes.Identity{}
+	for _, badge := range badges {
+		identity.Badges = append(identity.Badges, kickwebhooktypes.Badge{Type: badge})
+	}
+	return kickwebhooktypes.ChatMessageSent{
+		MessageID:   "message-id",
+		Broadcaster: kickwebhooktypes.User{UserID: 1},
+		Sender:      kickwebhooktypes.User{UserID: 2, Identity: identity},
+		Content:     content,
+	}
+}
+
+func Test_EngineBannedWordTimeout_Success(t *testing.T) {
+	// Arrange
+	var deletes []string
+	var requests []kickapitypes.ModerationRequest
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if req.Method == http.MethodDelete {
+				deletes = append(deletes, req.URL.Path)
+				return mocks.NewMockResponse(http.StatusNoContent, ""), nil
+			}
+
+			var moderationRequest kickapitypes.ModerationRequest
+			_ = json.NewDecoder(req.Body).Decode(&moderationRequest)
+			requests = append(requests, moderationRequest)
+			return mocks.NewMockResponse(http.StatusOK, `{"data":{},"message":"OK"}`), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	engine, _ := kickautomod.NewEngine(kickautomod.EngineConfig{
+		Chat:       client.Chat(),
+		Moderation: client.Moderation(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "moderator-token", nil
+		},
+		Rules: []kickautomod.Rule{
+			{
+				Name:   "banned-words",
+				Check:  kickautomod.BannedWords("spoiler"),
+				Action: kickautomod.Action{Type: kickautomod.ActionTimeout, Duration: 90 * time.Second},
+			},
+		},
+		Logger: slog.New(slog.DiscardHandler),
+	})
+
+	// Act
+	cleanDecisions, _ := engine.Handle(t.Context(), automodMessage("no spoilers here"))
+	decisions, err := engine.Handle(t.Context(), automodMessage("SPOILER: he dies"))
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(cleanDecisions) != 0 {
+		t.Fatalf("Expected banned words to match whole words only, got %+v", cleanDecisions)
+	}
+
+	if len(decisions) != 1 || decisions[0].Rule != "banned-words" {
+		t.Fatalf("Expected the banned-words rule to fire, got %+v", decisions)
+	}
+
+	if len(requests) != 1 {
+		t.Fatalf("Expected 1 timeout, got %d", len(requests))
+	}
+
+	request := requests[0]
+	if request.UserID != 2 || request.Duration == nil || *request.Duration != 2 || request.Reason == nil || *request.Reason != "banned-words" {
+		t.Fatalf("Expected a 2 minute timeout with the rule as reason, got %+v", request)
+	}
+}
+
+func Test_EngineLinksExemptBadge_Success(t *testing.T) {
+	// Arrange
+	var deletes []string
+	var requests []kickapitypes.ModerationRequest
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if req.Method == http.MethodDelete {
+				deletes = append(deletes, req.URL.Path)
+				return mocks.NewMockResponse(http.StatusNoContent, ""), nil
+			}
+
+			var moderationRequest kickapitypes.ModerationRequest
+			_ = json.NewDecoder(req.Body).Decode(&moderationRequest)
+			requests = append(requests, moderationRequest)
+			return mocks.NewMockResponse(http.StatusOK, `{"data":{},"message":"OK"}`), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	engine, _ := kickautomod.NewEngine(kickautomod.EngineConfig{
+		Chat:       client.Chat(),
+		Moderation: client.Moderation(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "moderator-token", nil
+		},
+		Rules: []kickautomod.Rule{
+			{
+				Name:         "links",
+				Check:        kickautomod.Links("kick.com"),
+				Action:       kickautomod.Action{Type: kickautomod.ActionDelete},
+				ExemptBadges: []string{"vip"},
+			},
+		},
+		Logger: slog.New(slog.DiscardHandler),
+	})
+
+	// Act
+	allowed, _ := engine.Handle(t.Context(), automodMessage("watch https://www.kick.com/streamer"))
+	vip, _ := engine.Handle(t.Context(), automodMessage("https://example.com", "vip"))
+	moderator, _ := engine.Handle(t.Context(), automodMessage("https://example.com", "moderator"))
+	blocked, err := engine.Handle(t.Context(), automodMessage("free stuff at example.com.evil.io and https://example.com"))
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(allowed) != 0 || len(vip) != 0 || len(moderator) != 0 {
+		t.Fatalf("Expected allowed domains and exempt badges to pass, got %+v, %+v and %+v", allowed, vip, moderator)
+	}
+
+	if len(blocked) != 1 || len(deletes) != 1 || deletes[0] != "/public/v1/chat/message-id" {
+		t.Fatalf("Expected the message to be deleted, got %+v and %q", blocked, deletes)
+	}
+}
+
+func Test_EngineDryRunAndShadow_Success(t *testing.T) {
+	// Arrange
+	var deletes []string
+	var requests []kickapitypes.ModerationRequest
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if req.Method == http.MethodDelete {
+				deletes = append(deletes, req.URL.Path)
+				return mocks.NewMockResponse(http.StatusNoContent, ""), nil
+			}
+
+			var moderationRequest kickapitypes.ModerationRequest
+			_ = json.NewDecoder(req.Body).Decode(&moderationRequest)
+			requests = append(requests, moderationRequest)
+			return mocks.NewMockResponse(http.StatusOK, `{"data":{},"message":"OK"}`), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	var logged []kickautomod.Decision
+	engine, _ := kickautomod.NewEngine(kickautomod.EngineConfig{
+		Chat:       client.Chat(),
+		Moderation: client.Moderation(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "moderator-token", nil
+		},
+		Rules: []kickautomod.Rule{
+			{Name: "caps", Check: kickautomod.CapsRatio(0.7, 5), Action: kickautomod.Action{Type: kickautomod.ActionDelete}, Shadow: true},
+			{Name: "regex", Check: kickautomod.BannedPatterns(regexp.MustCompile(`(?i)buy\s+followers`)), Action: kickautomod.Action{Type: kickautomod.ActionBan}},
+		},
+		OnDecision: func(decision kickautomod.Decision) {
+			logged = append(logged, decision)
+		},
+		Logger: slog.New(slog.DiscardHandler),
+	})
+
+	// Act
+	decisions, err := engine.Handle(t.Context(), automodMessage("BUY FOLLOWERS NOW"))
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(decisions) != 2 || decisions[0].Rule != "caps" || !decisions[0].DryRun || decisions[1].Rule != "regex" || decisions[1].DryRun {
+		t.Fatalf("Expected the shadow rule to be logged and the next rule to act, got %+v", decisions)
+	}
+
+	if len(logged) != 2 {
+		t.Fatalf("Expected every decision to be reported, got %d", len(logged))
+	}
+
+	if len(deletes) != 0 || len(requests) != 1 || requests[0].Duration != nil {
+		t.Fatalf("Expected only the ban, got %q and %+v", deletes, requests)
+	}
+}
+
+func Test_EngineDryRun_Success(t *testing.T) {
+	// Arrange
+	var deletes []string
+	var requests []kickapitypes.ModerationRequest
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if req.Method == http.MethodDelete {
+				deletes = append(deletes, req.URL.Path)
+				return mocks.NewMockResponse(http.StatusNoContent, ""), nil
+			}
+
+			var moderationRequest kickapitypes.ModerationRequest
+			_ = json.NewDecoder(req.Body).Decode(&moderationRequest)
+			requests = append(requests, moderationRequest)
+			return mocks.NewMockResponse(http.StatusOK, `{"data":{},"message":"OK"}`), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	engine, _ := kickautomod.NewEngine(kickautomod.EngineConfig{
+		Chat:       client.Chat(),
+		Moderation: client.Moderation(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "moderator-token", nil
+		},
+		DryRun: true,
+		Rules: []kickautomod.Rule{
+			{Name: "emotes", Check: kickautomod.EmoteSpam(2), Action: kickautomod.Action{Type: kickautomod.ActionDelete}},
+		},
+		Logger: slog.New(slog.DiscardHandler),
+	})
+
+	// Act
+	decisions, _ := engine.Handle(t.Context(), automodMessage("[emote:1:a] [emote:1:a] [emote:1:a]"))
+
+	// Assert
+	if len(decisions) != 1 || !decisions[0].DryRun {
+		t.Fatalf("Expected a dry-run decision, got %+v", decisions)
+	}
+
+	if len(deletes) != 0 {
+		t.Fatal("Expected no action in dry-run mode")
+	}
+}
+
+func Test_EngineRepeatedMessagesFromNewAccount_Success(t *testing.T) {
+	// Arrange
+	var deletes []string
+	var requests []kickapitypes.ModerationRequest
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if req.Method == http.MethodDelete {
+				deletes = append(deletes, req.URL.Path)
+				return mocks.NewMockResponse(http.StatusNoContent, ""), nil
+			}
+
+			var moderationRequest kickapitypes.ModerationRequest
+			_ = json.NewDecoder(req.Body).Decode(&moderationRequest)
+			requests = append(requests, moderationRequest)
+			return mocks.NewMockResponse(http.StatusOK, `{"data":{},"message":"OK"}`), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	engine, _ := kickautomod.NewEngine(kickautomod.EngineConfig{
+		Chat:       client.Chat(),
+		Moderation: client.Moderation(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "moderator-token", nil
+		},
+		Rules: []kickautomod.Rule{
+			{Name: "repeat", Check: kickautomod.All(kickautomod.NewAccounts(time.Hour), kickautomod.RepeatedMessages(2)), Action: kickautomod.Action{Type: kickautomod.ActionDelete}},
+		},
+		Logger: slog.New(slog.DiscardHandler),
+	})
+
+	// Act
+	first, _ := engine.Handle(t.Context(), automodMessage("hello"))
+	second, _ := engine.Handle(t.Context(), automodMessage("Hello "))
+	third, _ := engine.Handle(t.Context(), automodMessage("hello"))
+	subscriber, _ := engine.Handle(t.Context(), automodMessage("hello", "subscriber"))
+
+	// Assert
+	if len(first) != 0 || len(second) != 0 {
+		t.Fatalf("Expected the first repeats to pass, got %+v and %+v", first, second)
+	}
+
+	if len(third) != 1 {
+		t.Fatalf("Expected the third repeat to be deleted, got %+v", third)
+	}
+
+	if len(subscriber) != 0 {
+		t.Fatalf("Expected chatters with badges not to be new accounts, got %+v", subscriber)
+	}
+}
+
+func Test_NewEngineInvalidTimeout_Error(t *testing.T) {
+	// Arrange
+	config := kickautomod.EngineConfig{
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) { return "token", nil },
+		Rules: []kickautomod.Rule{
+			{Name: "caps", Check: kickautomod.CapsRatio(0.7, 5), Action: kickautomod.Action{Type: kickautomod.ActionTimeout, Duration: 8 * 24 * time.Hour}},
+		},
+	}
+
+	// Act
+	_, err := kickautomod.NewEngine(config)
+
+	// Assert
+	validationErr := kickerrors.IsValidationError(err)
+	if validationErr == nil || validationErr.Field != "Rules.Action.Duration" {
+		t.Fatalf("Expected a validation error for the duration, got %v", err)
+	}
+}
@@ -0,0 +1,271 @@
+package kick_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/henrikah/kick-go-sdk/v2"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickbanlist"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/tests/mocks"
+)
+
+func Test_BulkBan_Success(t *testing.T) {
+	// Arrange
+	var (
+		mu       sync.Mutex
+		requests []kickapitypes.ModerationRequest
+		inFlight int
+		peak     int
+	)
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			mu.Lock()
+			inFlight++
+			peak = max(peak, inFlight)
+			mu.Unlock()
+
+			time.Sleep(time.Millisecond)
+
+			var moderationRequest kickapitypes.ModerationRequest
+			_ = json.NewDecoder(req.Body).Decode(&moderationRequest)
+
+			mu.Lock()
+			defer mu.Unlock()
+			inFlight--
+			requests = append(requests, moderationRequest)
+			return mocks.NewMockResponse(http.StatusOK, `{"data":{},"message":"OK"}`), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	bulk, _ := kickbanlist.NewBulk(kickbanlist.BulkConfig{
+		Moderation: client.Moderation(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "moderator-token", nil
+		},
+		Concurrency: 2,
+		Interval:    time.Microsecond,
+	})
+	list := kickbanlist.List{
+		{UserID: 10, Reason: "spam bot"},
+		{UserID: 11, ExpiresAt: time.Now().Add(90 * time.Minute)},
+		{UserID: 12, ExpiresAt: time.Now().Add(-time.Hour)},
+	}
+
+	// Act
+	report, err := bulk.Ban(t.Context(), []int{1, 2}, list)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(report.Results) != 6 || report.Succeeded() != 4 {
+		t.Fatalf("Expected 6 results with 4 actions, got %+v", report.Results)
+	}
+
+	expected := []kickbanlist.Action{kickbanlist.ActionBan, kickbanlist.ActionTimeout, kickbanlist.ActionSkip}
+	for i, result := range report.Results {
+		if result.Action != expected[i%3] || result.BroadcasterUserID != 1+i/3 {
+			t.Fatalf("Expected result %d to be %s in channel %d, got %+v", i, expected[i%3], 1+i/3, result)
+		}
+	}
+
+	for _, request := range requests {
+		if request.UserID == 11 && (request.Duration == nil || *request.Duration != 90) {
+			t.Fatalf("Expected a timeout until the expiry, got %+v", request)
+		}
+		if request.UserID == 10 && (request.Duration != nil || *request.Reason != "spam bot") {
+			t.Fatalf("Expected a permanent ban with reason, got %+v", request)
+		}
+	}
+
+	if peak > 2 {
+		t.Fatalf("Expected at most 2 requests in flight, got %d", peak)
+	}
+}
+
+func Test_BulkBanExpiringWithinMinute_Success(t *testing.T) {
+	// Arrange
+	var requests []kickapitypes.ModerationRequest
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			var moderationRequest kickapitypes.ModerationRequest
+			_ = json.NewDecoder(req.Body).Decode(&moderationRequest)
+			requests = append(requests, moderationRequest)
+			return mocks.NewMockResponse(http.StatusOK, `{"data":{},"message":"OK"}`), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	bulk, _ := kickbanlist.NewBulk(kickbanlist.BulkConfig{
+		Moderation: client.Moderation(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "moderator-token", nil
+		},
+		Interval: time.Microsecond,
+	})
+	list := kickbanlist.List{{UserID: 10, ExpiresAt: time.Now().Add(30 * time.Second)}}
+
+	// Act
+	report, err := bulk.Ban(t.Context(), []int{1}, list)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if report.Succeeded() != 1 || report.Results[0].Action != kickbanlist.ActionTimeout {
+		t.Fatalf("Expected a timeout, got %+v", report.Results)
+	}
+
+	if len(requests) != 1 || requests[0].Duration == nil || *requests[0].Duration != 1 {
+		t.Fatalf("Expected a timeout of 1 minute, got %+v", requests)
+	}
+}
+
+func Test_BulkBanPartialFailure_Error(t *testing.T) {
+	// Arrange
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			var moderationRequest kickapitypes.ModerationRequest
+			_ = json.NewDecoder(req.Body).Decode(&moderationRequest)
+			if moderationRequest.UserID == 11 {
+				return mocks.NewMockResponse(http.StatusBadRequest, `{"message":"user is a moderator"}`), nil
+			}
+			return mocks.NewMockResponse(http.StatusOK, `{"data":{},"message":"OK"}`), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	bulk, _ := kickbanlist.NewBulk(kickbanlist.BulkConfig{
+		Moderation: client.Moderation(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "moderator-token", nil
+		},
+		Concurrency: 2,
+		Interval:    time.Microsecond,
+	})
+	list := kickbanlist.List{{UserID: 10}, {UserID: 11}, {UserID: 12, ExpiresAt: time.Now().Add(30 * 24 * time.Hour)}}
+
+	// Act
+	report, err := bulk.Ban(t.Context(), []int{1}, list)
+
+	// Assert
+	bulkErr := kickerrors.IsBulkModerationError(err)
+	if bulkErr == nil {
+		t.Fatalf("Expected a bulk moderation error, got %v", err)
+	}
+
+	if bulkErr.TotalTargets != 3 || len(bulkErr.Failed) != 2 {
+		t.Fatalf("Expected 2 of 3 targets to fail, got %+v", bulkErr)
+	}
+
+	if kickerrors.IsAPIError(report.Failed()[0].Err) == nil || kickerrors.IsValidationError(report.Failed()[1].Err) == nil {
+		t.Fatalf("Expected an API error and a validation error, got %+v", report.Failed())
+	}
+}
+
+func Test_BulkUnban_Success(t *testing.T) {
+	// Arrange
+	var (
+		mu     sync.Mutex
+		unbans []kickapitypes.UnBanRequest
+	)
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			var unbanRequest kickapitypes.UnBanRequest
+			_ = json.NewDecoder(req.Body).Decode(&unbanRequest)
+
+			mu.Lock()
+			defer mu.Unlock()
+			unbans = append(unbans, unbanRequest)
+			return mocks.NewMockResponse(http.StatusOK, `{"data":{},"message":"OK"}`), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	bulk, _ := kickbanlist.NewBulk(kickbanlist.BulkConfig{
+		Moderation: client.Moderation(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "moderator-token", nil
+		},
+		Concurrency: 2,
+		Interval:    time.Microsecond,
+	})
+
+	// Act
+	report, err := bulk.Unban(t.Context(), []int{1, 2, 3}, []int{10, 11})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if report.Succeeded() != 6 || len(unbans) != 6 {
+		t.Fatalf("Expected 6 unbans, got %d", len(unbans))
+	}
+}
+
+func Test_ListCSVRoundTrip_Success(t *testing.T) {
+	// Arrange
+	expiresAt := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	list := kickbanlist.List{
+		{UserID: 10, Username: "spammer", Reason: "spam, links"},
+		{UserID: 11, Reason: "rude", ExpiresAt: expiresAt},
+	}
+	var buffer bytes.Buffer
+
+	// Act
+	writeErr := list.WriteCSV(&buffer)
+	read, readErr := kickbanlist.ReadCSV(strings.NewReader(buffer.String()))
+
+	// Assert
+	if writeErr != nil || readErr != nil {
+		t.Fatalf("Expected no error, got %v and %v", writeErr, readErr)
+	}
+
+	if !strings.HasPrefix(buffer.String(), "user_id,username,reason,expires_at\n") {
+		t.Fatalf("Expected a header row, got %q", buffer.String())
+	}
+
+	if len(read) != 2 || read[0] != list[0] || read[1].UserID != 11 || !read[1].ExpiresAt.Equal(expiresAt) {
+		t.Fatalf("Expected the list to round trip, got %+v", read)
+	}
+}
+
+func Test_ListJSONRoundTrip_Success(t *testing.T) {
+	// Arrange
+	list := kickbanlist.List{{UserID: 10, Reason: "spam"}, {UserID: 11, ExpiresAt: time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)}}
+	var buffer bytes.Buffer
+
+	// Act
+	writeErr := list.WriteJSON(&buffer)
+	read, readErr := kickbanlist.ReadJSON(&buffer)
+
+	// Assert
+	if writeErr != nil || readErr != nil {
+		t.Fatalf("Expected no error, got %v and %v", writeErr, readErr)
+	}
+
+	if len(read) != 2 || read[0] != list[0] || !read[1].ExpiresAt.Equal(list[1].ExpiresAt) {
+		t.Fatalf("Expected the list to round trip, got %+v", read)
+	}
+}
+
+func Test_ReadCSVInvalidUserID_Error(t *testing.T) {
+	// Arrange
+	input := "user_id,username,reason,expires_at\nabc,,,\n"
+
+	// Act
+	_, err := kickbanlist.ReadCSV(strings.NewReader(input))
+
+	// Assert
+	if err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Fatalf("Expected an error naming the line, got %v", err)
+	}
+}
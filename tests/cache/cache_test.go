@@ -0,0 +1,320 @@
+package kick_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/henrikah/kick-go-sdk/v2"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickcache"
+	"github.com/henrikah/kick-go-sdk/v2/kickcontracts"
+	"github.com/henrikah/kick-go-sdk/v2/kickfilters"
+	"github.com/henrikah/kick-go-sdk/v2/kickwebhooktypes"
+	"github.com/henrikah/kick-go-sdk/v2/tests/mocks"
+)
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newCountingAPIClient(t *testing.T, requests *atomic.Int32, respond func(req *http.Request) string) kickcontracts.APIClient {
+	t.Helper()
+
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requests.Add(1)
+			return mocks.NewMockResponse(http.StatusOK, respond(req)), nil
+		},
+	}
+
+	client, err := kick.NewAPIClient(kickapitypes.APIClientConfig{
+		HTTPClient: httpClient,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+// usersResponse returns every requested user except the ID 404.
+func usersResponse(req *http.Request) string {
+	var entries []string
+	for _, id := range req.URL.Query()["id"] {
+		if id == "404" {
+			continue
+		}
+		entries = append(entries, fmt.Sprintf(`{"user_id": %s, "name": "user-%s", "email": "user-%s@example.com"}`, id, id, id))
+	}
+	return fmt.Sprintf(`{"data": [%s], "message": "OK"}`, strings.Join(entries, ","))
+}
+
+func Test_CacheUsersReadThrough_Success(t *testing.T) {
+	// Arrange
+	var requests atomic.Int32
+	apiClient := newCountingAPIClient(t, &requests, usersResponse)
+	cache := kickcache.New(kickcache.Config{})
+	users := cache.User(apiClient.User())
+
+	// Act
+	first, err := users.GetUsersByID(t.Context(), "access-token", []int64{1, 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := users.GetUsersByID(t.Context(), "access-token", []int64{2, 1, 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Assert
+	if requests.Load() != 2 {
+		t.Fatalf("Expected 2 requests, got %d", requests.Load())
+	}
+
+	if len(first.Data) != 2 || len(second.Data) != 3 {
+		t.Fatalf("Unexpected data lengths %d and %d", len(first.Data), len(second.Data))
+	}
+
+	if second.Data[0].UserID != 2 || second.Data[1].UserID != 1 || second.Data[2].UserID != 3 {
+		t.Fatal("Expected users in input order")
+	}
+
+	if second.Data[0].Email != "" {
+		t.Fatal("Expected email to not be shared through the cache")
+	}
+}
+
+func Test_CacheUsersNegativeCaching_Success(t *testing.T) {
+	// Arrange
+	var requests atomic.Int32
+	apiClient := newCountingAPIClient(t, &requests, usersResponse)
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	cache := kickcache.New(kickcache.Config{
+		NegativeTTL: time.Minute,
+		UserTTL:     time.Hour,
+		Now:         clock.Now,
+	})
+	users := cache.User(apiClient.User())
+
+	// Act
+	for range 3 {
+		usersData, err := users.GetUserByID(t.Context(), "access-token", 404)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(usersData.Data) != 0 {
+			t.Fatal("Expected no users")
+		}
+	}
+
+	clock.Advance(2 * time.Minute)
+	if _, err := users.GetUserByID(t.Context(), "access-token", 404); err != nil {
+		t.Fatal(err)
+	}
+
+	// Assert
+	if requests.Load() != 2 {
+		t.Fatalf("Expected 2 requests, got %d", requests.Load())
+	}
+}
+
+func Test_CacheMaxEntries_Success(t *testing.T) {
+	// Arrange
+	var requests atomic.Int32
+	apiClient := newCountingAPIClient(t, &requests, usersResponse)
+	cache := kickcache.New(kickcache.Config{MaxEntries: 2})
+	users := cache.User(apiClient.User())
+
+	// Act
+	for _, id := range []int64{1, 2, 3} {
+		if _, err := users.GetUserByID(t.Context(), "access-token", id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := users.GetUserByID(t.Context(), "access-token", 1); err != nil {
+		t.Fatal(err)
+	}
+
+	// Assert
+	if cache.Len() != 2 {
+		t.Fatalf("Expected 2 entries, got %d", cache.Len())
+	}
+
+	if requests.Load() != 4 {
+		t.Fatalf("Expected the least recently used user to be evicted, got %d requests", requests.Load())
+	}
+}
+
+func Test_CacheCoalescesConcurrentRequests_Success(t *testing.T) {
+	// Arrange
+	var requests atomic.Int32
+	release := make(chan struct{})
+	apiClient := newCountingAPIClient(t, &requests, func(req *http.Request) string {
+		<-release
+		return usersResponse(req)
+	})
+	cache := kickcache.New(kickcache.Config{})
+	users := cache.User(apiClient.User())
+
+	// Act
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			usersData, err := users.GetUsersByID(t.Context(), "access-token", []int64{7, 8})
+			if err != nil || len(usersData.Data) != 2 {
+				t.Errorf("Unexpected result: %v", err)
+			}
+		}()
+	}
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	// Assert
+	if requests.Load() != 1 {
+		t.Fatalf("Expected 1 request, got %d", requests.Load())
+	}
+}
+
+func Test_CacheCoalescedRequestOutlivesCanceledCaller_Success(t *testing.T) {
+	// Arrange
+	var requests atomic.Int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+	apiClient := newCountingAPIClient(t, &requests, func(req *http.Request) string {
+		close(started)
+		<-release
+		return usersResponse(req)
+	})
+	cache := kickcache.New(kickcache.Config{})
+	users := cache.User(apiClient.User())
+
+	ctx, cancel := context.WithCancel(t.Context())
+	canceled := make(chan error, 1)
+	go func() {
+		_, err := users.GetUsersByID(ctx, "access-token", []int64{7})
+		canceled <- err
+	}()
+	<-started
+
+	waited := make(chan error, 1)
+	go func() {
+		usersData, err := users.GetUsersByID(t.Context(), "access-token", []int64{7})
+		if err == nil && len(usersData.Data) != 1 {
+			err = fmt.Errorf("expected 1 user, got %d", len(usersData.Data))
+		}
+		waited <- err
+	}()
+	time.Sleep(50 * time.Millisecond)
+
+	// Act
+	cancel()
+	canceledErr := <-canceled
+	close(release)
+	waitedErr := <-waited
+
+	// Assert
+	if !errors.Is(canceledErr, context.Canceled) {
+		t.Fatalf("Expected the canceled caller to stop waiting, got %v", canceledErr)
+	}
+
+	if waitedErr != nil {
+		t.Fatalf("Expected the other caller to get the users, got %v", waitedErr)
+	}
+
+	if requests.Load() != 1 {
+		t.Fatalf("Expected 1 request, got %d", requests.Load())
+	}
+}
+
+func Test_CacheChannelWebhookInvalidation_Success(t *testing.T) {
+	// Arrange
+	var requests atomic.Int32
+	apiClient := newCountingAPIClient(t, &requests, func(req *http.Request) string {
+		return fmt.Sprintf(`{"data": [{"broadcaster_user_id": 5, "slug": "five", "stream_title": "title-%d", "stream": {"key": "secret"}}], "message": "OK"}`, requests.Load())
+	})
+	cache := kickcache.New(kickcache.Config{})
+	channels := cache.Channel(apiClient.Channel())
+
+	// Act
+	if _, err := channels.GetChannelsByBroadcasterSlug(t.Context(), "access-token", []string{"five"}); err != nil {
+		t.Fatal(err)
+	}
+	cached, err := channels.GetChannelByBroadcasterUserID(t.Context(), "access-token", 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cache.OnLivestreamMetadataUpdated(kickwebhooktypes.LivestreamMetadataUpdated{
+		Broadcaster: kickwebhooktypes.User{UserID: 5, ChannelSlug: "five"},
+	})
+
+	refreshed, err := channels.GetChannelsByBroadcasterSlug(t.Context(), "access-token", []string{"Five"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Assert
+	if requests.Load() != 2 {
+		t.Fatalf("Expected 2 requests, got %d", requests.Load())
+	}
+
+	if cached.Data[0].StreamTitle != "title-1" || refreshed.Data[0].StreamTitle != "title-2" {
+		t.Fatalf("Unexpected titles %s and %s", cached.Data[0].StreamTitle, refreshed.Data[0].StreamTitle)
+	}
+
+	if cached.Data[0].Stream.Key != "" {
+		t.Fatal("Expected stream key to not be shared through the cache")
+	}
+}
+
+func Test_CacheCategories_Success(t *testing.T) {
+	// Arrange
+	var requests atomic.Int32
+	apiClient := newCountingAPIClient(t, &requests, func(req *http.Request) string {
+		return `{"data": [{"id": 42, "name": "Software Development"}], "message": "OK"}`
+	})
+	cache := kickcache.New(kickcache.Config{})
+	categories := cache.Category(apiClient.Category())
+
+	// Act
+	for range 3 {
+		categoriesData, err := categories.SearchCategories(t.Context(), "access-token", kickfilters.NewCategoriesFilter().WithCategoryIDs([]int64{42}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if categoriesData.Data[0].ID != 42 {
+			t.Fatal("Unexpected category")
+		}
+	}
+	cache.InvalidateCategories()
+	if _, err := categories.SearchCategories(t.Context(), "access-token", kickfilters.NewCategoriesFilter().WithCategoryIDs([]int64{42})); err != nil {
+		t.Fatal(err)
+	}
+
+	// Assert
+	if requests.Load() != 2 {
+		t.Fatalf("Expected 2 requests, got %d", requests.Load())
+	}
+}
@@ -0,0 +1,225 @@
+package kick_test
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/henrikah/kick-go-sdk/v2"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickcassette"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/kickoauthtypes"
+	"github.com/henrikah/kick-go-sdk/v2/tests/mocks"
+)
+
+func recordUsers(t *testing.T, path string) {
+	t.Helper()
+
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if req.URL.Path == "/public/v1/chat" {
+				return mocks.NewMockResponse(http.StatusOK, `{"data": {"is_sent": true, "message_id": "message-1"}, "message": "OK"}`), nil
+			}
+			return mocks.NewMockResponse(http.StatusOK, `{"data": [{"user_id": 1, "name": "user", "email": "someone@example.com"}], "message": "OK"}`), nil
+		},
+	}
+
+	cassette, err := kickcassette.New(kickcassette.Config{
+		Path:       path,
+		Mode:       kickcassette.ModeRecord,
+		HTTPClient: httpClient,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{
+		HTTPClient: cassette,
+	})
+
+	users, err := client.User().GetUserByID(t.Context(), "recorded-access-token", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if users.Data[0].Email != "someone@example.com" {
+		t.Fatal("Expected the recorded response to be returned unscrubbed to the caller")
+	}
+
+	if _, err := client.Chat().SendChatMessageAsBot(t.Context(), "recorded-access-token", nil, "hello"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := cassette.Save(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func Test_CassetteRecordScrubsSecrets_Success(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "cassettes", "users.json")
+
+	// Act
+	recordUsers(t, path)
+
+	// Assert
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(string(content), "recorded-access-token") {
+		t.Fatal("Expected access token to be scrubbed")
+	}
+
+	if strings.Contains(string(content), "someone@example.com") {
+		t.Fatal("Expected email to be scrubbed")
+	}
+
+	if !strings.Contains(string(content), "/public/v1/users?id=1") {
+		t.Fatalf("Expected request URL to be recorded, got %s", content)
+	}
+}
+
+func Test_CassetteReplay_Success(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "users.json")
+	recordUsers(t, path)
+
+	cassette, err := kickcassette.New(kickcassette.Config{Path: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{
+		HTTPClient: cassette,
+	})
+
+	// Act
+	users, usersErr := client.User().GetUserByID(t.Context(), "another-access-token", 1)
+	chat, chatErr := client.Chat().SendChatMessageAsBot(t.Context(), "another-access-token", nil, "hello")
+
+	// Assert
+	if usersErr != nil || chatErr != nil {
+		t.Fatalf("Expected replay to succeed, got %v and %v", usersErr, chatErr)
+	}
+
+	if len(users.Data) != 1 || users.Data[0].UserID != 1 {
+		t.Fatalf("Expected replayed user, got %+v", users.Data)
+	}
+
+	if chat.Data.MessageID != "message-1" {
+		t.Fatalf("Expected replayed message ID, got %q", chat.Data.MessageID)
+	}
+
+	if unused := cassette.Unused(); len(unused) != 0 {
+		t.Fatalf("Expected every interaction to be used, got %d unused", len(unused))
+	}
+}
+
+func Test_CassetteReplayUnmatched_Error(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "users.json")
+	recordUsers(t, path)
+
+	cassette, err := kickcassette.New(kickcassette.Config{Path: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{
+		HTTPClient: cassette,
+	})
+
+	// Act
+	_, queryErr := client.User().GetUserByID(t.Context(), "access-token", 2)
+	_, bodyErr := client.Chat().SendChatMessageAsBot(t.Context(), "access-token", nil, "goodbye")
+	_, firstErr := client.User().GetUserByID(t.Context(), "access-token", 1)
+	_, repeatErr := client.User().GetUserByID(t.Context(), "access-token", 1)
+
+	// Assert
+	if kickerrors.IsUnmatchedRequestError(queryErr) == nil {
+		t.Fatalf("Expected unmatched request error for a different query, got %v", queryErr)
+	}
+
+	unmatched := kickerrors.IsUnmatchedRequestError(bodyErr)
+	if unmatched == nil || !strings.Contains(unmatched.Body, "goodbye") {
+		t.Fatalf("Expected unmatched request error for a different body, got %v", bodyErr)
+	}
+
+	if firstErr != nil {
+		t.Fatal(firstErr)
+	}
+
+	if kickerrors.IsUnmatchedRequestError(repeatErr) == nil {
+		t.Fatalf("Expected interactions to be used only once, got %v", repeatErr)
+	}
+
+	if unused := cassette.Unused(); len(unused) != 1 {
+		t.Fatalf("Expected 1 unused interaction, got %d", len(unused))
+	}
+}
+
+func Test_CassetteRecordScrubsClientSecret_Success(t *testing.T) {
+	// Arrange
+	path := filepath.Join(t.TempDir(), "oauth.json")
+
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return mocks.NewMockResponse(http.StatusOK, `{"access_token": "issued-token", "token_type": "Bearer", "expires_in": 3600}`), nil
+		},
+	}
+
+	cassette, _ := kickcassette.New(kickcassette.Config{
+		Path:       path,
+		Mode:       kickcassette.ModeRecord,
+		HTTPClient: httpClient,
+	})
+
+	client, _ := kick.NewOAuthClient(kickoauthtypes.OAuthClientConfig{
+		ClientID:     "client-id",
+		ClientSecret: "client-secret-value",
+		HTTPClient:   cassette,
+	})
+
+	// Act
+	if _, err := client.GetAppAccessToken(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	if err := cassette.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Assert
+	content, _ := os.ReadFile(path)
+	if strings.Contains(string(content), "client-secret-value") || strings.Contains(string(content), "issued-token") {
+		t.Fatalf("Expected client secret and issued token to be scrubbed, got %s", content)
+	}
+
+	replay, err := kickcassette.New(kickcassette.Config{Path: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	replayClient, _ := kick.NewOAuthClient(kickoauthtypes.OAuthClientConfig{
+		ClientID:     "client-id",
+		ClientSecret: "another-client-secret",
+		HTTPClient:   replay,
+	})
+
+	if _, err := replayClient.GetAppAccessToken(t.Context()); err != nil {
+		t.Fatalf("Expected replay with another client secret to match, got %v", err)
+	}
+}
+
+func Test_CassetteReplayMissingFile_Error(t *testing.T) {
+	// Act
+	_, err := kickcassette.New(kickcassette.Config{Path: filepath.Join(t.TempDir(), "missing.json")})
+
+	// Assert
+	if err == nil {
+		t.Fatal("Expected an error, got nil")
+	}
+}
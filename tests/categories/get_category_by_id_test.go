@@ -0,0 +1,248 @@
+package kick_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/henrikah/kick-go-sdk/v2"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/tests/mocks"
+)
+
+func Test_GetCategoryByIDMissingAccessToken_Error(t *testing.T) {
+	// Arrange
+	httpClient := http.DefaultClient
+
+	accessToken := ""
+
+	config := kickapitypes.APIClientConfig{
+		HTTPClient: httpClient,
+	}
+	client, _ := kick.NewAPIClient(config)
+
+	// Act
+	categoryData, err := client.Category().GetCategoryByID(t.Context(), accessToken, 42)
+
+	// Assert
+	if categoryData != nil {
+		t.Fatal("Expected categoryData to be nil")
+	}
+
+	if err == nil {
+		t.Fatal("Expected an error, got nil")
+	}
+
+	validationErr := kickerrors.IsValidationError(err)
+	if validationErr == nil {
+		t.Fatalf("Expected validation error, got %T", err)
+	}
+
+	if validationErr.Field != "accessToken" {
+		t.Fatalf("Expected error on field 'accessToken', got '%s'", validationErr.Field)
+	}
+}
+
+func Test_GetCategoryByIDInvalidCategoryID_Error(t *testing.T) {
+	// Arrange
+	httpClient := http.DefaultClient
+
+	accessToken := "access-token"
+
+	config := kickapitypes.APIClientConfig{
+		HTTPClient: httpClient,
+	}
+	client, _ := kick.NewAPIClient(config)
+
+	// Act
+	categoryData, err := client.Category().GetCategoryByID(t.Context(), accessToken, -42)
+
+	// Assert
+	if categoryData != nil {
+		t.Fatal("Expected categoryData to be nil")
+	}
+
+	if err == nil {
+		t.Fatal("Expected an error, got nil")
+	}
+
+	validationErr := kickerrors.IsValidationError(err)
+	if validationErr == nil {
+		t.Fatalf("Expected validation error, got %T", err)
+	}
+
+	if validationErr.Field != "categoryID" {
+		t.Fatalf("Expected error on field 'categoryID', got '%s'", validationErr.Field)
+	}
+}
+
+func Test_GetCategoryByIDNotFound_Error(t *testing.T) {
+	// Arrange
+	errorJSON := `{"message": "Not Found"}`
+
+	accessToken := "access-token"
+
+	mockClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return mocks.NewMockResponse(http.StatusNotFound, errorJSON), nil
+		},
+	}
+
+	config := kickapitypes.APIClientConfig{
+		HTTPClient: mockClient,
+	}
+	client, _ := kick.NewAPIClient(config)
+
+	// Act
+	categoryData, err := client.Category().GetCategoryByID(t.Context(), accessToken, 42)
+
+	// Assert
+	if err == nil {
+		t.Fatal("Expected an error, got nil")
+	}
+
+	if categoryData != nil {
+		t.Fatal("Expected categoryData to be nil on error")
+	}
+
+	apiErr := kickerrors.IsAPIError(err)
+	if apiErr == nil {
+		t.Fatalf("Expected API error, got %T", err)
+	}
+
+	if apiErr.StatusCode != http.StatusNotFound {
+		t.Fatalf("Expected status code %d, got %d", http.StatusNotFound, apiErr.StatusCode)
+	}
+}
+
+func Test_GetCategoryByID_Success(t *testing.T) {
+	// Arrange
+	accessToken := "access-token"
+	id := 42
+	name := "Test Category"
+	thumbnail := "https://test-thumbnail"
+
+	expectedJSON := fmt.Sprintf(`{
+		"data": {
+			"id": %d,
+			"name": "%s",
+			"thumbnail": "%s"
+		},
+		"message": "test-message"
+	}`, id, name, thumbnail)
+
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if req.URL.String() != fmt.Sprintf("https://api.kick.com/public/v1/categories/%d", id) {
+				t.Fatalf("Unexpected request URL: %s", req.URL.String())
+			}
+
+			if req.Method != "GET" {
+				t.Fatalf("Unexpected request method: %s", req.Method)
+			}
+
+			if req.Header.Get("Authorization") != "Bearer "+accessToken {
+				t.Fatal("Missing Authorization header")
+			}
+
+			return mocks.NewMockResponse(http.StatusOK, expectedJSON), nil
+		},
+	}
+
+	config := kickapitypes.APIClientConfig{
+		HTTPClient: httpClient,
+	}
+
+	client, _ := kick.NewAPIClient(config)
+
+	// Act
+	categoryData, err := client.Category().GetCategoryByID(t.Context(), accessToken, id)
+
+	// Assert
+	if categoryData == nil {
+		t.Fatal("Expected categoryData to not be nil")
+	}
+
+	if err != nil {
+		t.Fatal("Expected error to be nil")
+	}
+
+	if categoryData.Data.ID != id {
+		t.Fatalf("Expected ID to be %d, got %d", id, categoryData.Data.ID)
+	}
+
+	if categoryData.Data.Name != name {
+		t.Fatalf("Expected Name to be %s, got %s", name, categoryData.Data.Name)
+	}
+
+	if categoryData.Data.Thumbnail != thumbnail {
+		t.Fatalf("Expected Thumbnail to be %s, got %s", thumbnail, categoryData.Data.Thumbnail)
+	}
+
+	if categoryData.Message != "test-message" {
+		t.Fatalf("Expected Message to be %s, got %s", "test-message", categoryData.Message)
+	}
+}
+
+func Test_GetCategoriesByIDInvalidCategoryID_Error(t *testing.T) {
+	// Arrange
+	config := kickapitypes.APIClientConfig{
+		HTTPClient: http.DefaultClient,
+	}
+	client, _ := kick.NewAPIClient(config)
+
+	// Act
+	categoriesData, err := client.Category().GetCategoriesByID(t.Context(), "access-token", []int{42, 0})
+
+	// Assert
+	if categoriesData != nil {
+		t.Fatal("Expected categoriesData to be nil")
+	}
+
+	validationErr := kickerrors.IsValidationError(err)
+	if validationErr == nil {
+		t.Fatalf("Expected validation error, got %T", err)
+	}
+
+	if validationErr.Field != "categoryID" {
+		t.Fatalf("Expected error on field 'categoryID', got '%s'", validationErr.Field)
+	}
+}
+
+func Test_GetCategoriesByIDSkipsNotFound_Success(t *testing.T) {
+	// Arrange
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			switch req.URL.Path {
+			case "/public/v1/categories/1":
+				return mocks.NewMockResponse(http.StatusOK, `{"data": {"id": 1, "name": "one"}, "message": "OK"}`), nil
+			case "/public/v1/categories/3":
+				return mocks.NewMockResponse(http.StatusOK, `{"data": {"id": 3, "name": "three"}, "message": "OK"}`), nil
+			default:
+				return mocks.NewMockResponse(http.StatusNotFound, `{"message": "Not Found"}`), nil
+			}
+		},
+	}
+
+	config := kickapitypes.APIClientConfig{
+		HTTPClient: httpClient,
+	}
+	client, _ := kick.NewAPIClient(config)
+
+	// Act
+	categoriesData, err := client.Category().GetCategoriesByID(t.Context(), "access-token", []int{3, 2, 1})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected error to be nil, got %v", err)
+	}
+
+	if len(categoriesData.Data) != 2 {
+		t.Fatalf("Expected 2 categories, got %d", len(categoriesData.Data))
+	}
+
+	if categoriesData.Data[0].ID != 3 || categoriesData.Data[1].ID != 1 {
+		t.Fatal("Expected categories in input order")
+	}
+}
@@ -0,0 +1,148 @@
+package kick_test
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/henrikah/kick-go-sdk/v2"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/tests/mocks"
+)
+
+func channelsJSONFromQuery(req *http.Request, key string) string {
+	values := req.URL.Query()[key]
+	entries := make([]string, len(values))
+	for i, value := range values {
+		if key == "slug" {
+			entries[i] = fmt.Sprintf(`{"slug": "%s"}`, value)
+		} else {
+			entries[i] = fmt.Sprintf(`{"broadcaster_user_id": %s}`, value)
+		}
+	}
+	return fmt.Sprintf(`{"data": [%s], "message": "OK"}`, strings.Join(entries, ","))
+}
+
+func Test_GetAllChannelsByBroadcasterUserIDMissingAccessToken_Error(t *testing.T) {
+	// Arrange
+	config := kickapitypes.APIClientConfig{
+		HTTPClient: http.DefaultClient,
+	}
+	client, _ := kick.NewAPIClient(config)
+
+	// Act
+	channelsData, err := client.Channel().GetAllChannelsByBroadcasterUserID(t.Context(), "", []int64{1})
+
+	// Assert
+	if channelsData != nil {
+		t.Fatal("Expected channelsData to be nil")
+	}
+
+	validationErr := kickerrors.IsValidationError(err)
+	if validationErr == nil {
+		t.Fatalf("Expected validation error, got %T", err)
+	}
+
+	if validationErr.Field != "accessToken" {
+		t.Fatalf("Expected error on field 'accessToken', got '%s'", validationErr.Field)
+	}
+}
+
+func Test_GetAllChannelsByBroadcasterUserID_Success(t *testing.T) {
+	// Arrange
+	accessToken := "access-token"
+	broadcasters := make([]int64, 120)
+	for i := range broadcasters {
+		broadcasters[i] = int64(i + 1)
+	}
+
+	var requests atomic.Int32
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requests.Add(1)
+			if count := len(req.URL.Query()["broadcaster_user_id"]); count > 50 {
+				t.Errorf("Expected at most 50 IDs per request, got %d", count)
+			}
+			return mocks.NewMockResponse(http.StatusOK, channelsJSONFromQuery(req, "broadcaster_user_id")), nil
+		},
+	}
+
+	config := kickapitypes.APIClientConfig{
+		HTTPClient:       httpClient,
+		BatchConcurrency: 2,
+	}
+	client, _ := kick.NewAPIClient(config)
+
+	// Act
+	channelsData, err := client.Channel().GetAllChannelsByBroadcasterUserID(t.Context(), accessToken, broadcasters)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected error to be nil, got %v", err)
+	}
+
+	if requests.Load() != 3 {
+		t.Fatalf("Expected 3 requests, got %d", requests.Load())
+	}
+
+	if len(channelsData.Data) != len(broadcasters) {
+		t.Fatalf("Expected %d channels, got %d", len(broadcasters), len(channelsData.Data))
+	}
+
+	for i, channel := range channelsData.Data {
+		if int64(channel.BroadcasterUserID) != broadcasters[i] {
+			t.Fatalf("Expected channel %d to have broadcaster %d, got %d", i, broadcasters[i], channel.BroadcasterUserID)
+		}
+	}
+}
+
+func Test_GetAllChannelsByBroadcasterSlugPartialFailure_Error(t *testing.T) {
+	// Arrange
+	accessToken := "access-token"
+	slugs := make([]string, 75)
+	for i := range slugs {
+		slugs[i] = fmt.Sprintf("slug-%d", i)
+	}
+
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if req.URL.Query().Get("slug") == "slug-50" {
+				return mocks.NewMockResponse(http.StatusTooManyRequests, `{"message": "Too many requests"}`), nil
+			}
+			return mocks.NewMockResponse(http.StatusOK, channelsJSONFromQuery(req, "slug")), nil
+		},
+	}
+
+	config := kickapitypes.APIClientConfig{
+		HTTPClient: httpClient,
+	}
+	client, _ := kick.NewAPIClient(config)
+
+	// Act
+	channelsData, err := client.Channel().GetAllChannelsByBroadcasterSlug(t.Context(), accessToken, slugs)
+
+	// Assert
+	batchErr := kickerrors.IsBatchError(err)
+	if batchErr == nil {
+		t.Fatalf("Expected batch error, got %T", err)
+	}
+
+	if batchErr.TotalChunks != 2 || len(batchErr.Failed) != 1 {
+		t.Fatalf("Expected 1 of 2 chunks to fail, got %d of %d", len(batchErr.Failed), batchErr.TotalChunks)
+	}
+
+	if batchErr.Failed[0].Index != 1 || batchErr.Failed[0].Offset != 50 || batchErr.Failed[0].Size != 25 {
+		t.Fatalf("Unexpected failed chunk: %+v", batchErr.Failed[0])
+	}
+
+	if apiErr := kickerrors.IsAPIError(err); apiErr == nil || apiErr.StatusCode != http.StatusTooManyRequests {
+		t.Fatal("Expected the API error of the failed chunk to be reachable")
+	}
+
+	if channelsData == nil || len(channelsData.Data) != 50 {
+		t.Fatal("Expected the successful chunk to be returned")
+	}
+}
@@ -101,7 +101,7 @@ func Test_GetChannelsByBroadcasterSlug_Success(t *testing.T) {
 				"is_mature": true,
 				"key": "key-1",
 				"language": "language-1",
-				"start_time": "start-time-1",
+				"start_time": "2025-01-14T16:08:06Z",
 				"thumbnail": "https://stream-1",
 				"url": "https://url-1",
 				"viewer_count": 5
@@ -123,7 +123,7 @@ func Test_GetChannelsByBroadcasterSlug_Success(t *testing.T) {
 				"is_mature": true,
 				"key": "key-2",
 				"language": "language-2",
-				"start_time": "start-time-2",
+				"start_time": "2025-01-14T17:08:06Z",
 				"thumbnail": "https://stream-2",
 				"url": "https://url-2",
 				"viewer_count": 7
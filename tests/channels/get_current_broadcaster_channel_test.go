@@ -29,7 +29,7 @@ func Test_GetCurrentBroadcasterChannel_Success(t *testing.T) {
 				"is_mature": true,
 				"key": "key-1",
 				"language": "language-1",
-				"start_time": "start-time-1",
+				"start_time": "2025-01-14T16:08:06Z",
 				"thumbnail": "https://stream-1",
 				"url": "https://url-1",
 				"viewer_count": 5
@@ -0,0 +1,94 @@
+package kick_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/henrikah/kick-go-sdk/v2"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/tests/mocks"
+)
+
+func Test_AcceptAllRewardRedemptions_Success(t *testing.T) {
+	// Arrange
+	redemptionIDs := make([]string, 60)
+	for i := range redemptionIDs {
+		redemptionIDs[i] = "redemption-" + strconv.Itoa(i)
+	}
+
+	var requests atomic.Int32
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requests.Add(1)
+			if req.URL.String() != "https://api.kick.com/public/v1/channels/rewards/redemptions/accept" {
+				t.Errorf("Unexpected request URL: %s", req.URL.String())
+			}
+
+			var body kickapitypes.RedemptionsIDs
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Errorf("Could not decode body: %v", err)
+			}
+			if len(body.IDs) > 25 {
+				t.Errorf("Expected at most 25 IDs per request, got %d", len(body.IDs))
+			}
+
+			return mocks.NewMockResponse(http.StatusOK, `{"data": [{"id": "`+body.IDs[0]+`", "reason": "already accepted"}], "message": "OK"}`), nil
+		},
+	}
+
+	config := kickapitypes.APIClientConfig{
+		HTTPClient: httpClient,
+	}
+	client, _ := kick.NewAPIClient(config)
+
+	// Act
+	decision, err := client.ChannelReward().AcceptAllRewardRedemptions(t.Context(), "access-token", redemptionIDs)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected error to be nil, got %v", err)
+	}
+
+	if requests.Load() != 3 {
+		t.Fatalf("Expected 3 requests, got %d", requests.Load())
+	}
+
+	expected := []string{"redemption-0", "redemption-25", "redemption-50"}
+	if len(decision.Data) != len(expected) {
+		t.Fatalf("Expected %d decisions, got %d", len(expected), len(decision.Data))
+	}
+	for i, id := range expected {
+		if decision.Data[i].ID != id {
+			t.Fatalf("Expected decision %d to be %s, got %s", i, id, decision.Data[i].ID)
+		}
+	}
+}
+
+func Test_RejectAllRewardRedemptionsMissingIDs_Error(t *testing.T) {
+	// Arrange
+	config := kickapitypes.APIClientConfig{
+		HTTPClient: http.DefaultClient,
+	}
+	client, _ := kick.NewAPIClient(config)
+
+	// Act
+	decision, err := client.ChannelReward().RejectAllRewardRedemptions(t.Context(), "access-token", []string{})
+
+	// Assert
+	if decision != nil {
+		t.Fatal("Expected decision to be nil")
+	}
+
+	validationErr := kickerrors.IsValidationError(err)
+	if validationErr == nil {
+		t.Fatalf("Expected validation error, got %T", err)
+	}
+
+	if validationErr.Field != "redemptionIDs" {
+		t.Fatalf("Expected error on field 'redemptionIDs', got '%s'", validationErr.Field)
+	}
+}
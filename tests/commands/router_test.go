@@ -0,0 +1,375 @@
+package kick_test
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/henrikah/kick-go-sdk/v2"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickcommand"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/kickwebhooktypes"
+	"github.com/henrikah/kick-go-sdk/v2/tests/mocks"
+)
+
+func chatMessage(content string, userID int, badges ...string) kickwebhooktypes.ChatMessageSent {
+	identity := &kickwebhooktypes.Identity{}
+	for _, badge := range badges {
+		identity.Badges = append(identity.Badges, kickwebhooktypes.Badge{Type: badge})
+	}
+	return kickwebhooktypes.ChatMessageSent{
+		MessageID:   "message-id",
+		Broadcaster: kickwebhooktypes.User{UserID: 1},
+		Sender:      kickwebhooktypes.User{UserID: userID, Identity: identity},
+		Content:     content,
+	}
+}
+
+func Test_RouterHandleAlias_Success(t *testing.T) {
+	// Arrange
+	var replies []kickapitypes.SendChatRequest
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			var chatRequest kickapitypes.SendChatRequest
+			_ = json.NewDecoder(req.Body).Decode(&chatRequest)
+			replies = append(replies, chatRequest)
+			return mocks.NewMockResponse(http.StatusOK, `{"data":{"is_sent":true,"message_id":"reply-id"},"message":"OK"}`), nil
+		},
+	}
+
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	router, _ := kickcommand.NewRouter(kickcommand.RouterConfig{
+		Chat: client.Chat(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "bot-token", nil
+		},
+		Logger: slog.New(slog.DiscardHandler),
+	})
+
+	var args []string
+	err := router.Register(kickcommand.Command{
+		Name:    "so",
+		Aliases: []string{"ShoutOut"},
+		Handler: func(ctx context.Context, invocation *kickcommand.Invocation) error {
+			args = invocation.Args
+			_, err := invocation.Reply(ctx, "Go follow "+invocation.Args[0])
+			return err
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Act
+	err = router.Handle(t.Context(), chatMessage(`  !SHOUTOUT streamer "great stream"`, 2))
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(args) != 2 || args[0] != "streamer" || args[1] != "great stream" {
+		t.Fatalf("Expected parsed arguments, got %q", args)
+	}
+
+	if len(replies) != 1 {
+		t.Fatalf("Expected 1 reply, got %d", len(replies))
+	}
+
+	if replies[0].Type != "bot" || replies[0].ReplyToMessageID == nil || *replies[0].ReplyToMessageID != "message-id" {
+		t.Fatalf("Expected a bot reply to the command message, got %+v", replies[0])
+	}
+}
+
+func Test_RouterHandlePermission_Success(t *testing.T) {
+	// Arrange
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: http.DefaultClient})
+	router, _ := kickcommand.NewRouter(kickcommand.RouterConfig{
+		Chat: client.Chat(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "bot-token", nil
+		},
+		Logger: slog.New(slog.DiscardHandler),
+	})
+
+	runs := 0
+	_ = router.Register(kickcommand.Command{
+		Name:       "ban",
+		Permission: kickcommand.PermissionModerator,
+		Handler: func(ctx context.Context, invocation *kickcommand.Invocation) error {
+			runs++
+			return nil
+		},
+	})
+
+	// Act
+	_ = router.Handle(t.Context(), chatMessage("!ban someone", 2, "subscriber", "vip"))
+	_ = router.Handle(t.Context(), chatMessage("!ban someone", 3, "moderator"))
+	_ = router.Handle(t.Context(), chatMessage("!ban someone", 1))
+
+	// Assert
+	if runs != 2 {
+		t.Fatalf("Expected the moderator and the broadcaster to run the command, got %d runs", runs)
+	}
+}
+
+func Test_RouterHandleCooldowns_Success(t *testing.T) {
+	// Arrange
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: http.DefaultClient})
+	router, _ := kickcommand.NewRouter(kickcommand.RouterConfig{
+		Chat: client.Chat(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "bot-token", nil
+		},
+		Logger: slog.New(slog.DiscardHandler),
+	})
+
+	userRuns := 0
+	globalRuns := 0
+	_ = router.Register(kickcommand.Command{
+		Name:         "hug",
+		UserCooldown: time.Minute,
+		Handler: func(ctx context.Context, invocation *kickcommand.Invocation) error {
+			userRuns++
+			return nil
+		},
+	})
+	_ = router.Register(kickcommand.Command{
+		Name:           "raffle",
+		GlobalCooldown: time.Minute,
+		Handler: func(ctx context.Context, invocation *kickcommand.Invocation) error {
+			globalRuns++
+			return nil
+		},
+	})
+
+	// Act
+	for _, userID := range []int{2, 2, 3} {
+		_ = router.Handle(t.Context(), chatMessage("!hug", userID))
+		_ = router.Handle(t.Context(), chatMessage("!raffle", userID))
+	}
+
+	// Assert
+	if userRuns != 2 {
+		t.Fatalf("Expected the user cooldown to block the second run of the same user, got %d runs", userRuns)
+	}
+
+	if globalRuns != 1 {
+		t.Fatalf("Expected the global cooldown to block every other run, got %d runs", globalRuns)
+	}
+}
+
+func Test_RouterHandleMissingArgs_Success(t *testing.T) {
+	// Arrange
+	var replies []kickapitypes.SendChatRequest
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			var chatRequest kickapitypes.SendChatRequest
+			_ = json.NewDecoder(req.Body).Decode(&chatRequest)
+			replies = append(replies, chatRequest)
+			return mocks.NewMockResponse(http.StatusOK, `{"data":{"is_sent":true,"message_id":"reply-id"},"message":"OK"}`), nil
+		},
+	}
+
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	router, _ := kickcommand.NewRouter(kickcommand.RouterConfig{
+		Chat: client.Chat(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "bot-token", nil
+		},
+		Logger: slog.New(slog.DiscardHandler),
+	})
+
+	runs := 0
+	_ = router.Register(kickcommand.Command{
+		Name:    "so",
+		Usage:   "<channel>",
+		MinArgs: 1,
+		Handler: func(ctx context.Context, invocation *kickcommand.Invocation) error {
+			runs++
+			return nil
+		},
+	})
+
+	// Act
+	err := router.Handle(t.Context(), chatMessage("!so", 2))
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if runs != 0 {
+		t.Fatal("Expected the handler not to run")
+	}
+
+	if len(replies) != 1 || replies[0].Content != "Usage: !so <channel>" {
+		t.Fatalf("Expected the usage as reply, got %+v", replies)
+	}
+}
+
+func Test_RouterHelp_Success(t *testing.T) {
+	// Arrange
+	var replies []kickapitypes.SendChatRequest
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			var chatRequest kickapitypes.SendChatRequest
+			_ = json.NewDecoder(req.Body).Decode(&chatRequest)
+			replies = append(replies, chatRequest)
+			return mocks.NewMockResponse(http.StatusOK, `{"data":{"is_sent":true,"message_id":"reply-id"},"message":"OK"}`), nil
+		},
+	}
+
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	router, _ := kickcommand.NewRouter(kickcommand.RouterConfig{
+		Chat: client.Chat(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "bot-token", nil
+		},
+		HelpUserCooldown:   -1,
+		HelpGlobalCooldown: -1,
+		Logger:             slog.New(slog.DiscardHandler),
+	})
+
+	noop := func(ctx context.Context, invocation *kickcommand.Invocation) error { return nil }
+	_ = router.Register(kickcommand.Command{Name: "uptime", Description: "Shows the uptime", Handler: noop})
+	_ = router.Register(kickcommand.Command{Name: "so", Aliases: []string{"shoutout"}, Usage: "<channel>", Description: "Shouts out a channel", Permission: kickcommand.PermissionModerator, Handler: noop})
+
+	// Act
+	_ = router.Handle(t.Context(), chatMessage("!help", 2))
+	_ = router.Handle(t.Context(), chatMessage("!help", 3, "moderator"))
+	_ = router.Handle(t.Context(), chatMessage("!help shoutout", 2))
+
+	// Assert
+	if len(replies) != 3 {
+		t.Fatalf("Expected 3 replies, got %d", len(replies))
+	}
+
+	if replies[0].Content != "Commands: !uptime, !help" {
+		t.Fatalf("Expected only the commands of the viewer, got %q", replies[0].Content)
+	}
+
+	if replies[1].Content != "Commands: !uptime, !so, !help" {
+		t.Fatalf("Expected every command for the moderator, got %q", replies[1].Content)
+	}
+
+	expected := "!so <channel> - Shouts out a channel (aliases: !shoutout; moderator only)"
+	if replies[2].Content != expected {
+		t.Fatalf("Expected %q, got %q", expected, replies[2].Content)
+	}
+}
+
+func Test_RouterCooldownReplies_Success(t *testing.T) {
+	// Arrange
+	var replies []kickapitypes.SendChatRequest
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			var chatRequest kickapitypes.SendChatRequest
+			_ = json.NewDecoder(req.Body).Decode(&chatRequest)
+			replies = append(replies, chatRequest)
+			return mocks.NewMockResponse(http.StatusOK, `{"data":{"is_sent":true,"message_id":"reply-id"},"message":"OK"}`), nil
+		},
+	}
+
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	router, _ := kickcommand.NewRouter(kickcommand.RouterConfig{
+		Chat: client.Chat(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "bot-token", nil
+		},
+		Logger: slog.New(slog.DiscardHandler),
+	})
+
+	_ = router.Register(kickcommand.Command{
+		Name:         "so",
+		Usage:        "<channel>",
+		MinArgs:      1,
+		UserCooldown: time.Minute,
+		Handler: func(ctx context.Context, invocation *kickcommand.Invocation) error {
+			return nil
+		},
+	})
+
+	// Act
+	for range 3 {
+		_ = router.Handle(t.Context(), chatMessage("!so", 2))
+		_ = router.Handle(t.Context(), chatMessage("!help", 2))
+	}
+
+	// Assert
+	if len(replies) != 2 {
+		t.Fatalf("Expected one usage and one help reply, got %d replies", len(replies))
+	}
+
+	if replies[0].Content != "Usage: !so <channel>" || replies[1].Content != "Commands: !so, !help" {
+		t.Fatalf("Expected the usage and the help as replies, got %+v", replies)
+	}
+}
+
+func Test_RouterRegisterDuplicateAlias_Error(t *testing.T) {
+	// Arrange
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: http.DefaultClient})
+	router, _ := kickcommand.NewRouter(kickcommand.RouterConfig{
+		Chat: client.Chat(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "bot-token", nil
+		},
+		Logger: slog.New(slog.DiscardHandler),
+	})
+
+	noop := func(ctx context.Context, invocation *kickcommand.Invocation) error { return nil }
+	_ = router.Register(kickcommand.Command{Name: "so", Handler: noop})
+
+	// Act
+	duplicateErr := router.Register(kickcommand.Command{Name: "shoutout", Aliases: []string{"SO"}, Handler: noop})
+	helpErr := router.Register(kickcommand.Command{Name: "help", Handler: noop})
+
+	// Assert
+	if kickerrors.IsValidationError(duplicateErr) == nil {
+		t.Fatalf("Expected validation error for the duplicate alias, got %v", duplicateErr)
+	}
+
+	if kickerrors.IsValidationError(helpErr) == nil {
+		t.Fatalf("Expected validation error for the help command, got %v", helpErr)
+	}
+
+	if _, found := router.CommandHelp("shoutout"); found {
+		t.Fatal("Expected the failed registration to leave the router unchanged")
+	}
+}
+
+func Test_RouterWebhookHandler_Success(t *testing.T) {
+	// Arrange
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: http.DefaultClient})
+	router, _ := kickcommand.NewRouter(kickcommand.RouterConfig{
+		Chat: client.Chat(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "bot-token", nil
+		},
+		Logger: slog.New(slog.DiscardHandler),
+	})
+
+	_ = router.Register(kickcommand.Command{
+		Name: "fail",
+		Handler: func(ctx context.Context, invocation *kickcommand.Invocation) error {
+			return context.DeadlineExceeded
+		},
+	})
+
+	recorder := httptest.NewRecorder()
+	request := httptest.NewRequest(http.MethodPost, "/webhook", strings.NewReader(""))
+
+	// Act
+	router.WebhookHandler()(recorder, request, kickwebhooktypes.KickWebhookHeaders{}, chatMessage("!fail", 2))
+
+	// Assert
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Expected status 200 for a failed command, got %d", recorder.Code)
+	}
+}
@@ -0,0 +1,163 @@
+package kick_test
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/henrikah/kick-go-sdk/v2"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickescalation"
+	"github.com/henrikah/kick-go-sdk/v2/kicktime"
+	"github.com/henrikah/kick-go-sdk/v2/kickwebhooktypes"
+	"github.com/henrikah/kick-go-sdk/v2/tests/mocks"
+)
+
+func Test_PolicyReportEscalates_Success(t *testing.T) {
+	// Arrange
+	var chat []kickapitypes.SendChatRequest
+	var moderation []kickapitypes.ModerationRequest
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if strings.HasSuffix(req.URL.Path, "/chat") {
+				var chatRequest kickapitypes.SendChatRequest
+				_ = json.NewDecoder(req.Body).Decode(&chatRequest)
+				chat = append(chat, chatRequest)
+				return mocks.NewMockResponse(http.StatusOK, `{"data":{"is_sent":true,"message_id":"warning-id"},"message":"OK"}`), nil
+			}
+
+			var moderationRequest kickapitypes.ModerationRequest
+			_ = json.NewDecoder(req.Body).Decode(&moderationRequest)
+			moderation = append(moderation, moderationRequest)
+			return mocks.NewMockResponse(http.StatusOK, `{"data":{},"message":"OK"}`), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	policy, _ := kickescalation.NewPolicy(kickescalation.PolicyConfig{
+		Chat:       client.Chat(),
+		Moderation: client.Moderation(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "moderator-token", nil
+		},
+		Logger: slog.New(slog.DiscardHandler),
+	})
+	offense := kickescalation.Offense{BroadcasterUserID: 1, UserID: 2, Username: "viewer", MessageID: "message-id", Reason: "spam"}
+
+	// Act
+	var steps []kickescalation.StepType
+	for range 5 {
+		outcome, err := policy.Report(t.Context(), offense)
+		if err != nil {
+			t.Fatal(err)
+		}
+		steps = append(steps, outcome.Step.Type)
+	}
+
+	// Assert
+	expected := []kickescalation.StepType{kickescalation.StepWarn, kickescalation.StepTimeout, kickescalation.StepTimeout, kickescalation.StepBan, kickescalation.StepBan}
+	for i := range expected {
+		if steps[i] != expected[i] {
+			t.Fatalf("Expected steps %v, got %v", expected, steps)
+		}
+	}
+
+	if len(chat) != 1 || chat[0].Content != "@viewer, warning: spam" || *chat[0].ReplyToMessageID != "message-id" {
+		t.Fatalf("Expected a warning in reply to the message, got %+v", chat)
+	}
+
+	if len(moderation) != 4 || *moderation[0].Duration != 5 || *moderation[1].Duration != 60 || moderation[2].Duration != nil {
+		t.Fatalf("Expected a 5 and a 60 minute timeout followed by bans, got %+v", moderation)
+	}
+}
+
+func Test_PolicyReportDecay_Success(t *testing.T) {
+	// Arrange
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return mocks.NewMockResponse(http.StatusOK, `{"data":{"is_sent":true,"message_id":"warning-id"},"message":"OK"}`), nil
+		},
+	}
+	store := kickescalation.NewMemoryStore()
+	_ = store.Save(t.Context(), 1, 2, []kickescalation.Infraction{
+		{At: time.Now().Add(-48 * time.Hour), Step: kickescalation.Step{Type: kickescalation.StepWarn}},
+		{At: time.Now().Add(-25 * time.Hour), Step: kickescalation.Step{Type: kickescalation.StepTimeout, Duration: 5 * time.Minute}},
+	})
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	policy, _ := kickescalation.NewPolicy(kickescalation.PolicyConfig{
+		Chat:       client.Chat(),
+		Moderation: client.Moderation(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "moderator-token", nil
+		},
+		Store:  store,
+		Logger: slog.New(slog.DiscardHandler),
+	})
+
+	// Act
+	outcome, err := policy.Report(t.Context(), kickescalation.Offense{BroadcasterUserID: 1, UserID: 2})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if outcome.Step.Type != kickescalation.StepWarn || outcome.Infractions != 1 {
+		t.Fatalf("Expected decayed infractions not to count, got %+v", outcome)
+	}
+}
+
+func Test_PolicyRecordBan_Success(t *testing.T) {
+	// Arrange
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return mocks.NewMockResponse(http.StatusOK, `{"data":{"is_sent":true,"message_id":"warning-id"},"message":"OK"}`), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	policy, _ := kickescalation.NewPolicy(kickescalation.PolicyConfig{
+		Chat:       client.Chat(),
+		Moderation: client.Moderation(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "moderator-token", nil
+		},
+		Logger: slog.New(slog.DiscardHandler),
+	})
+	now := time.Now()
+
+	manualTimeout := kickwebhooktypes.ModerationBanned{
+		Broadcaster: kickwebhooktypes.User{UserID: 1},
+		Moderator:   kickwebhooktypes.User{UserID: 3},
+		BannedUser:  kickwebhooktypes.User{UserID: 2},
+		Metadata: kickwebhooktypes.ModerationBannedMetadata{
+			Reason:    "rude",
+			CreatedAt: kicktime.New(now.Add(-time.Hour)),
+			ExpiresAt: kicktime.New(now.Add(-time.Hour + 10*time.Minute)),
+		},
+	}
+
+	// Act
+	recordErr := policy.RecordBan(t.Context(), manualTimeout)
+	outcome, _ := policy.Report(t.Context(), kickescalation.Offense{BroadcasterUserID: 1, UserID: 2})
+	echo := manualTimeout
+	echo.Metadata.CreatedAt = kicktime.New(now)
+	echo.Metadata.ExpiresAt = kicktime.New(now.Add(5 * time.Minute))
+	echoErr := policy.RecordBan(t.Context(), echo)
+	history, _ := policy.History(t.Context(), 1, 2)
+
+	// Assert
+	if recordErr != nil || echoErr != nil {
+		t.Fatalf("Expected no error, got %v and %v", recordErr, echoErr)
+	}
+
+	if outcome.Step.Type != kickescalation.StepTimeout || outcome.Level != 2 {
+		t.Fatalf("Expected the manual timeout to escalate the next offense, got %+v", outcome)
+	}
+
+	if len(history) != 2 || !history[0].Manual || history[0].Step.Duration != 10*time.Minute || history[1].Manual {
+		t.Fatalf("Expected the echo of the policy timeout not to be counted, got %+v", history)
+	}
+}
@@ -122,12 +122,12 @@ func Test_CreateEventsSubscriptionsUnAuthorized_Error(t *testing.T) {
 func Test_CreateEventsSubscriptions_Success(t *testing.T) {
 	// Arrange
 	accessToken := "access-token"
-	events := []kickwebhookenum.WebhookType{kickwebhookenum.ChatMessageSent, kickwebhookenum.ChannelFollowed}
+	events := []kickwebhookenum.WebhookType{kickwebhookenum.ChatMessageSent}
 
 	expectedJSON := `{
 		"data": [{
-			"error": "error-1",
-			"name": "name-1",
+			"error": "",
+			"name": "chat.message.sent",
 			"subscription_id": "subscription-id-1",
 			"version": 1
 		}],
@@ -200,8 +200,8 @@ func Test_CreateEventsSubscriptions_Success(t *testing.T) {
 		t.Fatalf("Expected 1 slice, got %d", len(eventsSubscriptionsData.Data))
 	}
 
-	if eventsSubscriptionsData.Data[0].Name != "name-1" {
-		t.Fatalf("Expected Name to be name-1, got %s", eventsSubscriptionsData.Data[0].Name)
+	if eventsSubscriptionsData.Data[0].Name != "chat.message.sent" {
+		t.Fatalf("Expected Name to be chat.message.sent, got %s", eventsSubscriptionsData.Data[0].Name)
 	}
 
 	if eventsSubscriptionsData.Message != "test-message" {
@@ -213,12 +213,12 @@ func Test_CreateEventsSubscriptionsAsApp_Success(t *testing.T) {
 	// Arrange
 	accessToken := "access-token"
 	broadcasterUserID := 1
-	events := []kickwebhookenum.WebhookType{kickwebhookenum.ChatMessageSent, kickwebhookenum.ChannelFollowed}
+	events := []kickwebhookenum.WebhookType{kickwebhookenum.ChatMessageSent}
 
 	expectedJSON := `{
 		"data": [{
-			"error": "error-1",
-			"name": "name-1",
+			"error": "",
+			"name": "chat.message.sent",
 			"subscription_id": "subscription-id-1",
 			"version": 1
 		}],
@@ -270,8 +270,8 @@ func Test_CreateEventsSubscriptionsAsApp_Success(t *testing.T) {
 		t.Fatalf("Expected 1 slice, got %d", len(eventsSubscriptionsData.Data))
 	}
 
-	if eventsSubscriptionsData.Data[0].Name != "name-1" {
-		t.Fatalf("Expected Name to be name-1, got %s", eventsSubscriptionsData.Data[0].Name)
+	if eventsSubscriptionsData.Data[0].Name != "chat.message.sent" {
+		t.Fatalf("Expected Name to be chat.message.sent, got %s", eventsSubscriptionsData.Data[0].Name)
 	}
 
 	if eventsSubscriptionsData.Message != "test-message" {
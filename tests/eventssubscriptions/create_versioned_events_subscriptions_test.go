@@ -0,0 +1,79 @@
+package kick_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/henrikah/kick-go-sdk/v2"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/tests/mocks"
+)
+
+func Test_CreateVersionedEventSubscriptionsInvalidVersion_Error(t *testing.T) {
+	// Arrange
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{
+		HTTPClient: http.DefaultClient,
+	})
+
+	events := []kickapitypes.EventObject{{Name: "chat.message.sent", Version: 0}}
+
+	// Act
+	response, err := client.EventsSubscription().CreateVersionedEventSubscriptions(t.Context(), "access-token", nil, events)
+
+	// Assert
+	if response != nil {
+		t.Fatal("Expected response to be nil")
+	}
+
+	validationErr := kickerrors.IsValidationError(err)
+	if validationErr == nil {
+		t.Fatalf("Expected validation error, got %T", err)
+	}
+
+	if validationErr.Field != "events.version" {
+		t.Fatalf("Expected error on field 'events.version', got '%s'", validationErr.Field)
+	}
+}
+
+func Test_CreateVersionedEventSubscriptions_Success(t *testing.T) {
+	// Arrange
+	var request kickapitypes.CreateEventSubscriptionRequest
+
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&request); err != nil {
+				t.Fatal(err)
+			}
+			return mocks.NewMockResponse(http.StatusOK, `{"data": [{"name": "chat.message.sent", "version": 2, "subscription_id": "subscription-1"}], "message": "OK"}`), nil
+		},
+	}
+
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{
+		HTTPClient: httpClient,
+	})
+
+	broadcasterUserID := 12345
+	events := []kickapitypes.EventObject{{Name: "chat.message.sent", Version: 2}}
+
+	// Act
+	response, err := client.EventsSubscription().CreateVersionedEventSubscriptions(t.Context(), "access-token", &broadcasterUserID, events)
+
+	// Assert
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if request.BroadcasterUserID == nil || *request.BroadcasterUserID != broadcasterUserID {
+		t.Fatal("Expected broadcaster user ID to be sent")
+	}
+
+	if len(request.Events) != 1 || request.Events[0].Version != 2 || request.Method != "webhook" {
+		t.Fatalf("Expected versioned webhook events to be sent, got %+v", request)
+	}
+
+	if response.Data[0].SubscriptionID != "subscription-1" {
+		t.Fatalf("Expected subscription ID, got %q", response.Data[0].SubscriptionID)
+	}
+}
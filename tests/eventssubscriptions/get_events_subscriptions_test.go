@@ -88,21 +88,21 @@ func Test_GetEventsSubscriptions_Success(t *testing.T) {
 		"data": [{
 			"app_id": "app-id-1",
 			"broadcaster_user_id": 1,
-			"created_at": "created-at-1",
+			"created_at": "2025-01-14T16:08:06Z",
 			"event": "event-1",
 			"id": "id-1",
 			"method": "method-1",
-			"updated_at": "updated-at-1",
+			"updated_at": "2025-01-14T16:08:06Z",
 			"version": 1
 		},
 		{
 			"app_id": "app-id-2",
 			"broadcaster_user_id": 2,
-			"created_at": "created-at-2",
+			"created_at": "2025-01-14T17:08:06Z",
 			"event": "event-2",
 			"id": "id-2",
 			"method": "method-2",
-			"updated_at": "updated-at-2",
+			"updated_at": "2025-01-14T17:08:06Z",
 			"version": 2
 		}],
 		"message": "test-message"
@@ -0,0 +1,98 @@
+package kick_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/henrikah/kick-go-sdk/v2"
+	"github.com/henrikah/kick-go-sdk/v2/enums/kickwebhookenum"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/tests/mocks"
+)
+
+const partialSubscriptionJSON = `{
+	"data": [
+		{"error": "", "name": "chat.message.sent", "subscription_id": "subscription-id-1", "version": 1},
+		{"error": "insufficient scope", "name": "channel.followed", "subscription_id": "", "version": 1}
+	],
+	"message": "OK"
+}`
+
+func Test_CreateEventSubscriptionsPartialFailure_Error(t *testing.T) {
+	// Arrange
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return mocks.NewMockResponse(http.StatusOK, partialSubscriptionJSON), nil
+		},
+	}
+
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{
+		HTTPClient: httpClient,
+	})
+
+	events := []kickwebhookenum.WebhookType{kickwebhookenum.ChatMessageSent, kickwebhookenum.ChannelFollowed, kickwebhookenum.KicksGifted}
+
+	// Act
+	response, err := client.EventsSubscription().CreateEventSubscriptions(t.Context(), "access-token", events)
+
+	// Assert
+	if response == nil || len(response.Data) != 2 {
+		t.Fatal("Expected the response to be returned alongside the error")
+	}
+
+	var partialErr *kickerrors.PartialSubscriptionError
+	if !errors.As(err, &partialErr) {
+		t.Fatalf("Expected partial subscription error, got %v", err)
+	}
+
+	if ids := partialErr.SubscriptionIDs(); len(ids) != 1 || ids[0] != "subscription-id-1" {
+		t.Fatalf("Expected the successful subscription ID, got %v", ids)
+	}
+
+	if len(partialErr.Failed) != 2 {
+		t.Fatalf("Expected 2 failed events, got %+v", partialErr.Failed)
+	}
+
+	if partialErr.Failed[0].Event != "channel.followed" || partialErr.Failed[0].Reason != "insufficient scope" {
+		t.Fatalf("Expected the rejected event with its reason, got %+v", partialErr.Failed[0])
+	}
+
+	if partialErr.Failed[1].Event != "kicks.gifted" || partialErr.Failed[1].Reason != "missing from response" {
+		t.Fatalf("Expected the missing event to fail, got %+v", partialErr.Failed[1])
+	}
+}
+
+func Test_CreateEventSubscriptionsStrictPartialFailure_Error(t *testing.T) {
+	// Arrange
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return mocks.NewMockResponse(http.StatusOK, partialSubscriptionJSON), nil
+		},
+	}
+
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{
+		HTTPClient:               httpClient,
+		StrictEventSubscriptions: true,
+	})
+
+	events := []kickwebhookenum.WebhookType{kickwebhookenum.ChatMessageSent, kickwebhookenum.ChannelFollowed}
+
+	// Act
+	response, err := client.EventsSubscription().CreateEventSubscriptionsAsApp(t.Context(), "access-token", 1, events)
+
+	// Assert
+	if response != nil {
+		t.Fatal("Expected response to be nil in strict mode")
+	}
+
+	partialErr := kickerrors.IsPartialSubscriptionError(err)
+	if partialErr == nil {
+		t.Fatalf("Expected partial subscription error, got %v", err)
+	}
+
+	if len(partialErr.Subscribed) != 1 || len(partialErr.Failed) != 1 {
+		t.Fatalf("Expected 1 subscribed and 1 failed event, got %+v", partialErr)
+	}
+}
@@ -0,0 +1,186 @@
+package kick_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/henrikah/kick-go-sdk/v2"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/kickinterceptor"
+	"github.com/henrikah/kick-go-sdk/v2/kickoauthtypes"
+	"github.com/henrikah/kick-go-sdk/v2/tests/mocks"
+)
+
+func Test_InterceptorsRunInOrder_Success(t *testing.T) {
+	// Arrange
+	var calls []string
+	var operations []string
+
+	record := func(name string) kickinterceptor.Interceptor {
+		return func(operation string, req *http.Request, next kickinterceptor.Invoker) (*http.Response, error) {
+			calls = append(calls, name+" before")
+			operations = append(operations, operation)
+			resp, err := next(req)
+			calls = append(calls, name+" after")
+			return resp, err
+		}
+	}
+
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			calls = append(calls, "http client")
+			return mocks.NewMockResponse(http.StatusOK, `{"data": {"is_sent": true, "message_id": "message-1"}, "message": "OK"}`), nil
+		},
+	}
+
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{
+		HTTPClient:   httpClient,
+		Interceptors: []kickinterceptor.Interceptor{record("first"), record("second")},
+	})
+
+	// Act
+	_, err := client.Chat().SendChatMessageAsBot(t.Context(), "access-token", nil, "hello")
+
+	// Assert
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedCalls := []string{"first before", "second before", "http client", "second after", "first after"}
+	if len(calls) != len(expectedCalls) {
+		t.Fatalf("Expected calls %v, got %v", expectedCalls, calls)
+	}
+	for i := range expectedCalls {
+		if calls[i] != expectedCalls[i] {
+			t.Fatalf("Expected calls %v, got %v", expectedCalls, calls)
+		}
+	}
+
+	for _, operation := range operations {
+		if operation != "Chat.SendChatMessageAsBot" {
+			t.Fatalf("Expected operation Chat.SendChatMessageAsBot, got %q", operation)
+		}
+	}
+}
+
+func Test_InterceptorAddsHeader_Success(t *testing.T) {
+	// Arrange
+	var requestID string
+
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requestID = req.Header.Get("X-Request-Id")
+			return mocks.NewMockResponse(http.StatusOK, `{"data": [], "message": "OK"}`), nil
+		},
+	}
+
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{
+		HTTPClient: httpClient,
+		Interceptors: []kickinterceptor.Interceptor{
+			func(operation string, req *http.Request, next kickinterceptor.Invoker) (*http.Response, error) {
+				req.Header.Set("X-Request-Id", "request-1")
+				return next(req)
+			},
+		},
+	})
+
+	// Act
+	_, err := client.User().GetUserByID(t.Context(), "access-token", 1)
+
+	// Assert
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if requestID != "request-1" {
+		t.Fatalf("Expected header to be set by the interceptor, got %q", requestID)
+	}
+}
+
+func Test_InterceptorInjectsFault_Error(t *testing.T) {
+	// Arrange
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			t.Fatal("Expected HTTP client to not be called")
+			return nil, nil
+		},
+	}
+
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{
+		HTTPClient: httpClient,
+		Interceptors: []kickinterceptor.Interceptor{
+			func(operation string, req *http.Request, next kickinterceptor.Invoker) (*http.Response, error) {
+				return mocks.NewMockResponse(http.StatusServiceUnavailable, `{"message": "injected"}`), nil
+			},
+		},
+	})
+
+	// Act
+	err := client.Chat().DeleteChatMessage(t.Context(), "access-token", "message-1")
+
+	// Assert
+	apiError := kickerrors.IsAPIError(err)
+	if apiError == nil {
+		t.Fatalf("Expected API error, got %v", err)
+	}
+
+	if apiError.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("Expected status %d, got %d", http.StatusServiceUnavailable, apiError.StatusCode)
+	}
+}
+
+func Test_InterceptorWithoutResponse_Error(t *testing.T) {
+	// Arrange
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{
+		HTTPClient: &mocks.MockHTTPClient{},
+		Interceptors: []kickinterceptor.Interceptor{
+			func(operation string, req *http.Request, next kickinterceptor.Invoker) (*http.Response, error) {
+				return nil, nil
+			},
+		},
+	})
+
+	// Act
+	_, err := client.User().GetUserByID(t.Context(), "access-token", 1)
+
+	// Assert
+	if err == nil {
+		t.Fatal("Expected an error, got nil")
+	}
+}
+
+func Test_OAuthClientInterceptor_Success(t *testing.T) {
+	// Arrange
+	var operation string
+
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return mocks.NewMockResponse(http.StatusOK, `{"access_token": "token", "token_type": "Bearer", "expires_in": 3600}`), nil
+		},
+	}
+
+	client, _ := kick.NewOAuthClient(kickoauthtypes.OAuthClientConfig{
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		HTTPClient:   httpClient,
+		Interceptors: []kickinterceptor.Interceptor{
+			func(op string, req *http.Request, next kickinterceptor.Invoker) (*http.Response, error) {
+				operation = op
+				return next(req)
+			},
+		},
+	})
+
+	// Act
+	_, err := client.GetAppAccessToken(t.Context())
+
+	// Assert
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if operation != "OAuth.GetAppAccessToken" {
+		t.Fatalf("Expected operation OAuth.GetAppAccessToken, got %q", operation)
+	}
+}
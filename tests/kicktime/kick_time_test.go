@@ -0,0 +1,190 @@
+package kick_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kicktime"
+	"github.com/henrikah/kick-go-sdk/v2/kickwebhooktypes"
+)
+
+func Test_KickTimeUnmarshalFormats_Success(t *testing.T) {
+	// Arrange
+	expected := time.Date(2025, 1, 14, 16, 8, 6, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "RFC3339", input: `"2025-01-14T16:08:06Z"`},
+		{name: "RFC3339 with offset", input: `"2025-01-14T17:08:06+01:00"`},
+		{name: "RFC3339 with offset without colon", input: `"2025-01-14T17:08:06+0100"`},
+		{name: "Fractional seconds", input: `"2025-01-14T16:08:06.000000Z"`},
+		{name: "Without zone", input: `"2025-01-14T16:08:06"`},
+		{name: "Space separated", input: `"2025-01-14 16:08:06"`},
+		{name: "Space separated with zone", input: `"2025-01-14 16:08:06 +0000 UTC"`},
+		{name: "Unix seconds", input: `1736870886`},
+		{name: "Unix milliseconds", input: `1736870886000`},
+		{name: "Unix seconds as string", input: `"1736870886"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			var kickTime kicktime.KickTime
+			err := json.Unmarshal([]byte(tt.input), &kickTime)
+
+			// Assert
+			if err != nil {
+				t.Fatalf("Expected error to be nil, got %v", err)
+			}
+
+			if !kickTime.Time().Equal(expected) {
+				t.Fatalf("Expected %s, got %s", expected, kickTime.Time())
+			}
+
+			marshaled, err := json.Marshal(kickTime)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(marshaled) != tt.input {
+				t.Fatalf("Expected lossless marshal of %s, got %s", tt.input, marshaled)
+			}
+		})
+	}
+}
+
+func Test_KickTimeEmptyAndNull_Success(t *testing.T) {
+	// Arrange
+	inputs := []string{`""`, `null`}
+
+	for _, input := range inputs {
+		// Act
+		var kickTime kicktime.KickTime
+		err := json.Unmarshal([]byte(input), &kickTime)
+
+		// Assert
+		if err != nil {
+			t.Fatalf("Expected error to be nil for %s, got %v", input, err)
+		}
+
+		if !kickTime.IsZero() {
+			t.Fatalf("Expected zero time for %s", input)
+		}
+
+		marshaled, _ := json.Marshal(kickTime)
+		if string(marshaled) != input {
+			t.Fatalf("Expected %s, got %s", input, marshaled)
+		}
+	}
+}
+
+func Test_KickTimeInvalid_Error(t *testing.T) {
+	// Arrange
+	input := `"yesterday"`
+
+	// Act
+	var kickTime kicktime.KickTime
+	err := json.Unmarshal([]byte(input), &kickTime)
+
+	// Assert
+	if err == nil {
+		t.Fatal("Expected an error, got nil")
+	}
+}
+
+func Test_KickTimeNew_Success(t *testing.T) {
+	// Arrange
+	now := time.Date(2025, 1, 14, 16, 8, 6, 500, time.UTC)
+
+	// Act
+	marshaled, err := json.Marshal(kicktime.New(now))
+
+	// Assert
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(marshaled) != `"2025-01-14T16:08:06.0000005Z"` {
+		t.Fatalf("Unexpected marshal result %s", marshaled)
+	}
+
+	zero, _ := json.Marshal(kicktime.KickTime{})
+	if string(zero) != "null" {
+		t.Fatalf("Expected zero value to marshal as null, got %s", zero)
+	}
+}
+
+func Test_KickTimeEqual_Success(t *testing.T) {
+	// Arrange
+	var utc, offset, empty kicktime.KickTime
+	if err := json.Unmarshal([]byte(`"2025-01-14T16:08:06Z"`), &utc); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(`"2025-01-14T16:08:06+00:00"`), &offset); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal([]byte(`""`), &empty); err != nil {
+		t.Fatal(err)
+	}
+
+	// Act
+	sameInstant := utc.Equal(offset)
+	emptyIsZero := empty.Equal(kicktime.KickTime{})
+	different := utc.Equal(kicktime.New(utc.Time().Add(time.Second)))
+
+	// Assert
+	if !sameInstant {
+		t.Fatal("Expected the same instant in different layouts to be equal")
+	}
+
+	if !emptyIsZero {
+		t.Fatal("Expected an empty timestamp to equal the zero value")
+	}
+
+	if different {
+		t.Fatal("Expected different instants to not be equal")
+	}
+}
+
+func Test_KickTimeInTypes_Success(t *testing.T) {
+	// Arrange
+	livestreamJSON := `{"data": [{"started_at": "2025-01-14T16:08:06Z"}]}`
+	statusJSON := `{"is_live": false, "started_at": "2025-01-14T16:08:06Z", "ended_at": "2025-01-14T18:38:06Z"}`
+	bannedJSON := `{"metadata": {"reason": "spam", "created_at": "2025-01-14T16:08:06Z", "expires_at": null}}`
+
+	// Act
+	var livestream kickapitypes.LivestreamResponse
+	var status kickwebhooktypes.LivestreamStatusUpdated
+	var banned kickwebhooktypes.ModerationBanned
+	var redelivered kickwebhooktypes.LivestreamStatusUpdated
+
+	for input, out := range map[string]any{livestreamJSON: &livestream, statusJSON: &status, bannedJSON: &banned} {
+		if err := json.Unmarshal([]byte(input), out); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := json.Unmarshal([]byte(statusJSON), &redelivered); err != nil {
+		t.Fatal(err)
+	}
+
+	// Assert
+	if livestream.Data[0].StartedAt.Time().Year() != 2025 {
+		t.Fatal("Expected StartedAt to be parsed")
+	}
+
+	if duration := status.EndedAt.Time().Sub(status.StartedAt.Time()); duration != 150*time.Minute {
+		t.Fatalf("Expected stream duration of 2h30m, got %s", duration)
+	}
+
+	if !banned.Metadata.ExpiresAt.IsZero() {
+		t.Fatal("Expected a permanent ban to have a zero ExpiresAt")
+	}
+
+	if status != redelivered {
+		t.Fatal("Expected the same event decoded twice to compare equal")
+	}
+}
@@ -97,7 +97,7 @@ func Test_GetCurrentUserLivestream_Success(t *testing.T) {
 			"has_mature_content": true,
 			"language": "en",
 			"slug": "slug-1",
-			"started_at": "started-at-1",
+			"started_at": "2025-01-14T16:08:06Z",
 			"stream_title": "stream-title-1",
 			"thumbnail": "thumbnail-1",
 			"viewer_count": 5
@@ -101,7 +101,7 @@ func Test_GetLivestreams_Success(t *testing.T) {
 			"has_mature_content": true,
 			"language": "en",
 			"slug": "slug-1",
-			"started_at": "started-at-1",
+			"started_at": "2025-01-14T16:08:06Z",
 			"stream_title": "stream-title-1",
 			"thumbnail": "thumbnail-1",
 			"viewer_count": 5
@@ -117,7 +117,7 @@ func Test_GetLivestreams_Success(t *testing.T) {
 			"has_mature_content": true,
 			"language": "en",
 			"slug": "slug-2",
-			"started_at": "started-at-2",
+			"started_at": "2025-01-14T17:08:06Z",
 			"stream_title": "stream-title-2",
 			"thumbnail": "thumbnail-2",
 			"viewer_count": 7
@@ -192,7 +192,7 @@ func Test_GetLivestreamsWithoutFilter_Success(t *testing.T) {
 			"has_mature_content": true,
 			"language": "en",
 			"slug": "slug-1",
-			"started_at": "started-at-1",
+			"started_at": "2025-01-14T16:08:06Z",
 			"stream_title": "stream-title-1",
 			"thumbnail": "thumbnail-1",
 			"viewer_count": 5
@@ -208,7 +208,7 @@ func Test_GetLivestreamsWithoutFilter_Success(t *testing.T) {
 			"has_mature_content": true,
 			"language": "en",
 			"slug": "slug-2",
-			"started_at": "started-at-2",
+			"started_at": "2025-01-14T17:08:06Z",
 			"stream_title": "stream-title-2",
 			"thumbnail": "thumbnail-2",
 			"viewer_count": 7
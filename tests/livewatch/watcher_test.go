@@ -0,0 +1,351 @@
+package kick_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/henrikah/kick-go-sdk/v2"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/kicklivewatch"
+	"github.com/henrikah/kick-go-sdk/v2/kicktime"
+	"github.com/henrikah/kick-go-sdk/v2/kickwebhooktypes"
+	"github.com/henrikah/kick-go-sdk/v2/tests/mocks"
+)
+
+func testLivestream(broadcasterUserID int, title string, categoryID int) kickapitypes.LivestreamResponseData {
+	return kickapitypes.LivestreamResponseData{
+		BroadcasterUserID: broadcasterUserID,
+		Slug:              "channel",
+		StreamTitle:       title,
+		Category:          kickapitypes.Category{ID: categoryID, Name: "Category"},
+		StartedAt:         kicktime.New(time.Date(2025, time.September, 1, 18, 0, 0, 0, time.UTC)),
+	}
+}
+
+func Test_WatcherPoll_Success(t *testing.T) {
+	// Arrange
+	live := map[int]kickapitypes.LivestreamResponseData{
+		1: testLivestream(1, "Already live", 10),
+	}
+	var queries [][]string
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			ids := req.URL.Query()["broadcaster_user_id"]
+			queries = append(queries, ids)
+
+			response := kickapitypes.LivestreamResponse{Data: []kickapitypes.LivestreamResponseData{}, Message: "OK"}
+			for _, id := range ids {
+				broadcasterUserID, _ := strconv.Atoi(id)
+				if livestream, ok := live[broadcasterUserID]; ok {
+					response.Data = append(response.Data, livestream)
+				}
+			}
+			body, _ := json.Marshal(response)
+			return mocks.NewMockResponse(http.StatusOK, string(body)), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+
+	var status []kickwebhooktypes.LivestreamStatusUpdated
+	var metadata []kickwebhooktypes.LivestreamMetadataUpdated
+	watcher, _ := kicklivewatch.NewWatcher(kicklivewatch.WatcherConfig{
+		Livestream: client.Livestream(),
+		AccessToken: func(ctx context.Context) (string, error) {
+			return "app-token", nil
+		},
+		Broadcasters: []int{1, 2, 3},
+		BatchSize:    2,
+		OnStatus: func(ctx context.Context, event kickwebhooktypes.LivestreamStatusUpdated) {
+			status = append(status, event)
+		},
+		OnMetadata: func(ctx context.Context, event kickwebhooktypes.LivestreamMetadataUpdated) {
+			metadata = append(metadata, event)
+		},
+	})
+
+	// Act
+	_, baselineErr := watcher.Poll(t.Context())
+
+	live[2] = testLivestream(2, "Hello", 20)
+	live[1] = testLivestream(1, "Already live", 11)
+	changed, changeErr := watcher.Poll(t.Context())
+
+	delete(live, 2)
+	_, offlineErr := watcher.Poll(t.Context())
+
+	unchanged, unchangedErr := watcher.Poll(t.Context())
+
+	// Assert
+	if baselineErr != nil || changeErr != nil || offlineErr != nil || unchangedErr != nil {
+		t.Fatalf("Expected no error, got %v, %v, %v and %v", baselineErr, changeErr, offlineErr, unchangedErr)
+	}
+
+	if len(queries) != 8 || len(queries[0]) != 2 || len(queries[1]) != 1 {
+		t.Fatalf("Expected 2 batches per poll, got %v", queries)
+	}
+
+	if !changed || unchanged {
+		t.Fatalf("Expected the second poll to change and the last not, got %v and %v", changed, unchanged)
+	}
+
+	if len(status) != 2 {
+		t.Fatalf("Expected 2 status events without the baseline, got %+v", status)
+	}
+	if wentLive := status[0]; !wentLive.IsLive || wentLive.Broadcaster.UserID != 2 || wentLive.Title != "Hello" || wentLive.StartedAt.Time().IsZero() {
+		t.Fatalf("Unexpected went live event %+v", wentLive)
+	}
+	if wentOffline := status[1]; wentOffline.IsLive || wentOffline.Broadcaster.UserID != 2 || wentOffline.EndedAt.Time().IsZero() {
+		t.Fatalf("Unexpected went offline event %+v", wentOffline)
+	}
+
+	if len(metadata) != 1 || metadata[0].Broadcaster.UserID != 1 || metadata[0].Metadata.Category.ID != 11 {
+		t.Fatalf("Expected 1 category change, got %+v", metadata)
+	}
+
+	if _, isLive := watcher.Live(2); isLive {
+		t.Fatal("Expected broadcaster 2 to be offline")
+	}
+}
+
+func Test_WatcherPollEmitInitial_Success(t *testing.T) {
+	// Arrange
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return mocks.NewMockResponse(http.StatusOK, `{"data":[{"broadcaster_user_id":1,"slug":"channel","stream_title":"Already live","started_at":"2025-09-01T18:00:00Z"}],"message":"OK"}`), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+
+	var status []kickwebhooktypes.LivestreamStatusUpdated
+	watcher, _ := kicklivewatch.NewWatcher(kicklivewatch.WatcherConfig{
+		Livestream: client.Livestream(),
+		AccessToken: func(ctx context.Context) (string, error) {
+			return "app-token", nil
+		},
+		Broadcasters: []int{1},
+		EmitInitial:  true,
+		OnStatus: func(ctx context.Context, event kickwebhooktypes.LivestreamStatusUpdated) {
+			status = append(status, event)
+		},
+	})
+
+	// Act
+	changed, err := watcher.Poll(t.Context())
+
+	// Assert
+	if err != nil || !changed {
+		t.Fatalf("Expected a change, got %v and %v", changed, err)
+	}
+
+	if len(status) != 1 || !status[0].IsLive || status[0].Broadcaster.ChannelSlug != "channel" {
+		t.Fatalf("Expected the live stream to be announced, got %+v", status)
+	}
+}
+
+func Test_WatcherPollFailedBatch_Error(t *testing.T) {
+	// Arrange
+	fail := false
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if fail {
+				return mocks.NewMockResponse(http.StatusInternalServerError, `{"message":"unavailable"}`), nil
+			}
+			return mocks.NewMockResponse(http.StatusOK, `{"data":[{"broadcaster_user_id":1,"slug":"channel","stream_title":"Live","started_at":"2025-09-01T18:00:00Z"}],"message":"OK"}`), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+
+	var status []kickwebhooktypes.LivestreamStatusUpdated
+	watcher, _ := kicklivewatch.NewWatcher(kicklivewatch.WatcherConfig{
+		Livestream: client.Livestream(),
+		AccessToken: func(ctx context.Context) (string, error) {
+			return "app-token", nil
+		},
+		Broadcasters: []int{1},
+		OnStatus: func(ctx context.Context, event kickwebhooktypes.LivestreamStatusUpdated) {
+			status = append(status, event)
+		},
+	})
+	if _, err := watcher.Poll(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+	fail = true
+
+	// Act
+	changed, err := watcher.Poll(t.Context())
+
+	// Assert
+	if err == nil || changed {
+		t.Fatalf("Expected an error without changes, got %v and %v", changed, err)
+	}
+
+	if _, live := watcher.Live(1); !live || len(status) != 0 {
+		t.Fatalf("Expected the failed batch to keep its state, got %+v", status)
+	}
+}
+
+func Test_WatcherPollFailedFirstBatch_Success(t *testing.T) {
+	// Arrange
+	requests := 0
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			requests++
+			if requests == 2 {
+				return mocks.NewMockResponse(http.StatusInternalServerError, `{"message":"unavailable"}`), nil
+			}
+			id := req.URL.Query().Get("broadcaster_user_id")
+			return mocks.NewMockResponse(http.StatusOK, `{"data":[{"broadcaster_user_id":`+id+`,"slug":"channel","stream_title":"Live","started_at":"2025-09-01T18:00:00Z"}],"message":"OK"}`), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+
+	var status []kickwebhooktypes.LivestreamStatusUpdated
+	watcher, _ := kicklivewatch.NewWatcher(kicklivewatch.WatcherConfig{
+		Livestream: client.Livestream(),
+		AccessToken: func(ctx context.Context) (string, error) {
+			return "app-token", nil
+		},
+		Broadcasters: []int{1, 2},
+		BatchSize:    1,
+		OnStatus: func(ctx context.Context, event kickwebhooktypes.LivestreamStatusUpdated) {
+			status = append(status, event)
+		},
+	})
+
+	// Act
+	_, firstErr := watcher.Poll(t.Context())
+	changed, secondErr := watcher.Poll(t.Context())
+
+	// Assert
+	if firstErr == nil || secondErr != nil {
+		t.Fatalf("Expected only the first poll to fail, got %v and %v", firstErr, secondErr)
+	}
+
+	if changed || len(status) != 0 {
+		t.Fatalf("Expected the stream of the failed batch to not be announced, got %+v", status)
+	}
+
+	if _, live := watcher.Live(2); !live {
+		t.Fatal("Expected the broadcaster of the failed batch to be recorded as live")
+	}
+}
+
+func Test_WatcherLiveDuringPoll_Success(t *testing.T) {
+	// Arrange
+	started := make(chan struct{})
+	release := make(chan struct{})
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			close(started)
+			<-release
+			return mocks.NewMockResponse(http.StatusOK, `{"data":[],"message":"OK"}`), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	watcher, _ := kicklivewatch.NewWatcher(kicklivewatch.WatcherConfig{
+		Livestream: client.Livestream(),
+		AccessToken: func(ctx context.Context) (string, error) {
+			return "app-token", nil
+		},
+		Broadcasters: []int{1},
+	})
+	polled := make(chan error, 1)
+	go func() {
+		_, err := watcher.Poll(t.Context())
+		polled <- err
+	}()
+	<-started
+
+	// Act
+	done := make(chan struct{})
+	go func() {
+		watcher.Live(1)
+		close(done)
+	}()
+
+	// Assert
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected Live to not wait for the search")
+	}
+
+	close(release)
+	if err := <-polled; err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+func Test_NewWatcherInvalidConfig_Error(t *testing.T) {
+	// Arrange
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: http.DefaultClient})
+	accessToken := func(ctx context.Context) (string, error) { return "app-token", nil }
+
+	cases := map[string]kicklivewatch.WatcherConfig{
+		"Broadcasters": {Livestream: client.Livestream(), AccessToken: accessToken},
+		"BatchSize":    {Livestream: client.Livestream(), AccessToken: accessToken, Broadcasters: []int{1}, BatchSize: 101},
+		"MaxInterval":  {Livestream: client.Livestream(), AccessToken: accessToken, Broadcasters: []int{1}, MaxInterval: 1},
+		"Jitter":       {Livestream: client.Livestream(), AccessToken: accessToken, Broadcasters: []int{1}, Jitter: 1},
+	}
+
+	for field, config := range cases {
+		// Act
+		watcher, err := kicklivewatch.NewWatcher(config)
+
+		// Assert
+		validationErr := kickerrors.IsValidationError(err)
+		if watcher != nil || validationErr == nil || validationErr.Field != field {
+			t.Fatalf("Expected validation error on field '%s', got %v", field, err)
+		}
+	}
+}
+
+func Test_WatcherPollCallbackCallsLive_Success(t *testing.T) {
+	// Arrange
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return mocks.NewMockResponse(http.StatusOK, `{"data":[{"broadcaster_user_id":1,"slug":"channel","stream_title":"Live","started_at":"2025-09-01T18:00:00Z"}],"message":"OK"}`), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+
+	var watcher *kicklivewatch.Watcher
+	var live bool
+	watcher, _ = kicklivewatch.NewWatcher(kicklivewatch.WatcherConfig{
+		Livestream: client.Livestream(),
+		AccessToken: func(ctx context.Context) (string, error) {
+			return "app-token", nil
+		},
+		Broadcasters: []int{1},
+		EmitInitial:  true,
+		OnStatus: func(ctx context.Context, event kickwebhooktypes.LivestreamStatusUpdated) {
+			_, live = watcher.Live(event.Broadcaster.UserID)
+		},
+	})
+
+	// Act
+	done := make(chan error, 1)
+	go func() {
+		_, err := watcher.Poll(t.Context())
+		done <- err
+	}()
+
+	// Assert
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected the callback to be able to call Live during Poll")
+	}
+
+	if !live {
+		t.Fatal("Expected the callback to see the broadcaster as live")
+	}
+}
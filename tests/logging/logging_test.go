@@ -0,0 +1,148 @@
+package kick_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/henrikah/kick-go-sdk/v2"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickoauthtypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickwebhooktypes"
+	"github.com/henrikah/kick-go-sdk/v2/tests/mocks"
+)
+
+func Test_APIClientLogsRedactedRequests_Success(t *testing.T) {
+	// Arrange
+	var output bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&output, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	accessToken := "super-secret-access-token"
+
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return mocks.NewMockResponse(http.StatusBadRequest, `{"message": "invalid", "email": "someone@example.com"}`), nil
+		},
+	}
+
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{
+		HTTPClient: httpClient,
+		Logger:     logger,
+	})
+
+	// Act
+	_, err := client.User().GetUserByID(t.Context(), accessToken, 1)
+
+	// Assert
+	if err == nil {
+		t.Fatal("Expected an error, got nil")
+	}
+
+	logs := output.String()
+	if !strings.Contains(logs, "kick: sending request") || !strings.Contains(logs, "kick: received error response") {
+		t.Fatalf("Expected request and response to be logged, got %s", logs)
+	}
+
+	if strings.Contains(logs, accessToken) {
+		t.Fatal("Expected access token to be redacted")
+	}
+
+	if strings.Contains(logs, "someone@example.com") {
+		t.Fatal("Expected email to be redacted")
+	}
+
+	if !strings.Contains(logs, `"status":400`) {
+		t.Fatalf("Expected status to be logged, got %s", logs)
+	}
+}
+
+func Test_OAuthClientLogsWithoutSecrets_Success(t *testing.T) {
+	// Arrange
+	var output bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&output, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return mocks.NewMockResponse(http.StatusOK, `{"access_token": "issued-token", "token_type": "Bearer", "expires_in": 3600}`), nil
+		},
+	}
+
+	client, _ := kick.NewOAuthClient(kickoauthtypes.OAuthClientConfig{
+		ClientID:     "client-id",
+		ClientSecret: "client-secret-value",
+		HTTPClient:   httpClient,
+		Logger:       logger,
+	})
+
+	// Act
+	if _, err := client.GetAppAccessToken(t.Context()); err != nil {
+		t.Fatal(err)
+	}
+
+	// Assert
+	logs := output.String()
+	if !strings.Contains(logs, "kick: received response") {
+		t.Fatalf("Expected response to be logged, got %s", logs)
+	}
+
+	if strings.Contains(logs, "client-secret-value") || strings.Contains(logs, "issued-token") {
+		t.Fatal("Expected secrets to not be logged")
+	}
+}
+
+func Test_WebhookClientLogsVerificationFailure_Success(t *testing.T) {
+	// Arrange
+	var output bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&output, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKeyBytes, _ := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	publicKey := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyBytes})
+
+	client, err := kick.NewWebhookClientWithConfig(kickwebhooktypes.WebhookClientConfig{
+		PublicKey: string(publicKey),
+		Logger:    logger,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = client.RegisterChatMessageSentHandler(func(w http.ResponseWriter, r *http.Request, h kickwebhooktypes.KickWebhookHeaders, data kickwebhooktypes.ChatMessageSent) {
+		t.Error("Expected handler to not be called")
+	})
+
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"content": "hi"}`))
+	request.Header.Set("Kick-Event-Message-Id", "message-1")
+	request.Header.Set("Kick-Event-Type", "chat.message.sent")
+	request.Header.Set("Kick-Event-Signature", "aW52YWxpZC1zaWduYXR1cmU=")
+
+	// Act
+	recorder := httptest.NewRecorder()
+	client.WebhookHandler(recorder, request)
+
+	// Assert
+	if recorder.Code != http.StatusUnauthorized {
+		t.Fatalf("Expected status %d, got %d", http.StatusUnauthorized, recorder.Code)
+	}
+
+	logs := output.String()
+	if !strings.Contains(logs, "kick: webhook signature verification failed") || !strings.Contains(logs, `"level":"WARN"`) {
+		t.Fatalf("Expected verification failure to be logged as warning, got %s", logs)
+	}
+
+	if strings.Count(logs, "\n") != 1 {
+		t.Fatalf("Expected the failure to be logged once, got %s", logs)
+	}
+
+	if strings.Contains(logs, "aW52YWxpZC1zaWduYXR1cmU=") {
+		t.Fatal("Expected signature to not be logged")
+	}
+}
@@ -0,0 +1,114 @@
+package kick_test
+
+import (
+	"testing"
+
+	"github.com/henrikah/kick-go-sdk/v2/kickmessage"
+	"github.com/henrikah/kick-go-sdk/v2/kickwebhooktypes"
+)
+
+func Test_ParseEmotePositions_Success(t *testing.T) {
+	// Arrange
+	message := kickwebhooktypes.ChatMessageSent{
+		Content: "🔥 héllo [emote:37226:KEKW] @streamer_1 see https://kick.com/streamer.",
+		Emotes: []kickwebhooktypes.Emote{
+			{EmoteID: "37226", Positions: []kickwebhooktypes.Position{{Start: 8, End: 25}}},
+		},
+	}
+
+	// Act
+	segments := kickmessage.Parse(message)
+
+	// Assert
+	expected := []kickmessage.Segment{
+		{Type: kickmessage.SegmentText, Text: "🔥 héllo ", Start: 0, End: 8},
+		{Type: kickmessage.SegmentEmote, Text: "[emote:37226:KEKW]", Start: 8, End: 26, EmoteID: "37226", EmoteURL: "https://files.kick.com/emotes/37226/fullsize", EmoteName: "KEKW"},
+		{Type: kickmessage.SegmentText, Text: " ", Start: 26, End: 27},
+		{Type: kickmessage.SegmentMention, Text: "@streamer_1", Start: 27, End: 38, Username: "streamer_1"},
+		{Type: kickmessage.SegmentText, Text: " see ", Start: 38, End: 43},
+		{Type: kickmessage.SegmentURL, Text: "https://kick.com/streamer", Start: 43, End: 68, URL: "https://kick.com/streamer"},
+		{Type: kickmessage.SegmentText, Text: ".", Start: 68, End: 69},
+	}
+
+	if len(segments) != len(expected) {
+		t.Fatalf("Expected %d segments, got %+v", len(expected), segments)
+	}
+
+	for i := range expected {
+		if segments[i] != expected[i] {
+			t.Fatalf("Expected segment %d to be %+v, got %+v", i, expected[i], segments[i])
+		}
+	}
+}
+
+func Test_ParseEmoteMarkupWithoutPositions_Success(t *testing.T) {
+	// Arrange
+	content := "gg [emote:1:PogU][emote:2:LUL] mail me@example.com www.kick.com"
+
+	// Act
+	segments := kickmessage.ParseContent(content, nil)
+
+	// Assert
+	var emotes, mentions, urls int
+	for _, segment := range segments {
+		switch segment.Type {
+		case kickmessage.SegmentEmote:
+			emotes++
+		case kickmessage.SegmentMention:
+			mentions++
+		case kickmessage.SegmentURL:
+			urls++
+			if segment.URL != "https://www.kick.com" {
+				t.Fatalf("Expected a scheme to be added, got %q", segment.URL)
+			}
+		}
+	}
+
+	if emotes != 2 || mentions != 0 || urls != 1 {
+		t.Fatalf("Expected 2 emotes, no mentions and 1 URL, got %d, %d and %d", emotes, mentions, urls)
+	}
+}
+
+func Test_ParseInvalidPositions_Success(t *testing.T) {
+	// Arrange
+	message := kickwebhooktypes.ChatMessageSent{
+		Content: "hi",
+		Emotes: []kickwebhooktypes.Emote{
+			{EmoteID: "1", Positions: []kickwebhooktypes.Position{{Start: 0, End: 10}, {Start: 1, End: 0}}},
+		},
+	}
+
+	// Act
+	segments := kickmessage.Parse(message)
+
+	// Assert
+	if len(segments) != 1 || segments[0].Type != kickmessage.SegmentText || segments[0].Text != "hi" {
+		t.Fatalf("Expected invalid positions to be ignored, got %+v", segments)
+	}
+}
+
+func Test_SegmentsRender_Success(t *testing.T) {
+	// Arrange
+	segments := kickmessage.ParseContent(`<b>*hi*</b> [emote:5:EZ] @bob https://kick.com`, nil)
+
+	// Act
+	plainText := segments.PlainText()
+	html := segments.HTML()
+	markdown := segments.Markdown()
+
+	// Assert
+	if expected := "<b>*hi*</b> EZ @bob https://kick.com"; plainText != expected {
+		t.Fatalf("Expected %q, got %q", expected, plainText)
+	}
+
+	expectedHTML := `&lt;b&gt;*hi*&lt;/b&gt; <img class="kick-emote" src="https://files.kick.com/emotes/5/fullsize" alt="EZ" title="EZ"> ` +
+		`<span class="kick-mention">@bob</span> <a href="https://kick.com" rel="nofollow noopener noreferrer" target="_blank">https://kick.com</a>`
+	if html != expectedHTML {
+		t.Fatalf("Expected %q, got %q", expectedHTML, html)
+	}
+
+	expectedMarkdown := `\<b\>\*hi\*\</b\> ![EZ](https://files.kick.com/emotes/5/fullsize) **@bob** <https://kick.com>`
+	if markdown != expectedMarkdown {
+		t.Fatalf("Expected %q, got %q", expectedMarkdown, markdown)
+	}
+}
@@ -0,0 +1,142 @@
+package kick_test
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/henrikah/kick-go-sdk/v2"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickmeta"
+	"github.com/henrikah/kick-go-sdk/v2/tests/mocks"
+)
+
+func Test_ResponseMetaCollected_Success(t *testing.T) {
+	// Arrange
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			resp := mocks.NewMockResponse(http.StatusOK, `{"data": [{"user_id": 1, "name": "user"}], "message": "OK"}`)
+			resp.Header = http.Header{"X-Ratelimit-Remaining": []string{"42"}}
+			return resp, nil
+		},
+	}
+
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{
+		HTTPClient: httpClient,
+	})
+
+	ctx, collector := kickmeta.WithCollector(t.Context())
+
+	// Act
+	_, err := client.User().GetUserByID(ctx, "access-token", 1)
+
+	// Assert
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	meta, ok := collector.Last()
+	if !ok {
+		t.Fatal("Expected response metadata to be collected")
+	}
+
+	if meta.Operation != "User.GetUserByID" {
+		t.Fatalf("Expected operation User.GetUserByID, got %q", meta.Operation)
+	}
+
+	if meta.StatusCode != http.StatusOK || meta.Attempts != 1 {
+		t.Fatalf("Expected status 200 after 1 attempt, got %d after %d", meta.StatusCode, meta.Attempts)
+	}
+
+	if meta.Header.Get("X-RateLimit-Remaining") != "42" {
+		t.Fatalf("Expected response headers, got %v", meta.Header)
+	}
+
+	if meta.RequestURL != "https://api.kick.com/public/v1/users?id=1" {
+		t.Fatalf("Expected request URL, got %q", meta.RequestURL)
+	}
+}
+
+func Test_ResponseMetaCollectedOnError_Error(t *testing.T) {
+	// Arrange
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if req.Method == http.MethodDelete {
+				return nil, errors.New("connection reset")
+			}
+			return mocks.NewMockResponse(http.StatusTooManyRequests, `{"message": "slow down"}`), nil
+		},
+	}
+
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{
+		HTTPClient: httpClient,
+	})
+
+	ctx, collector := kickmeta.WithCollector(t.Context())
+
+	// Act
+	_, sendErr := client.Chat().SendChatMessageAsBot(ctx, "access-token", nil, "hello")
+	deleteErr := client.Chat().DeleteChatMessage(ctx, "access-token", "message-1")
+
+	// Assert
+	if sendErr == nil || deleteErr == nil {
+		t.Fatal("Expected both calls to fail")
+	}
+
+	all := collector.All()
+	if len(all) != 2 {
+		t.Fatalf("Expected 2 recorded responses, got %d", len(all))
+	}
+
+	if all[0].StatusCode != http.StatusTooManyRequests || all[0].Operation != "Chat.SendChatMessageAsBot" {
+		t.Fatalf("Expected rate limited send, got %+v", all[0])
+	}
+
+	if all[1].StatusCode != 0 || all[1].Header != nil || all[1].Operation != "Chat.DeleteChatMessage" {
+		t.Fatalf("Expected delete without response, got %+v", all[1])
+	}
+
+	collector.Reset()
+	if _, ok := collector.Last(); ok {
+		t.Fatal("Expected collector to be empty after reset")
+	}
+}
+
+func Test_ResponseMetaPerChunk_Success(t *testing.T) {
+	// Arrange
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return mocks.NewMockResponse(http.StatusOK, `{"data": [], "message": "OK"}`), nil
+		},
+	}
+
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{
+		HTTPClient: httpClient,
+	})
+
+	userIDs := make([]int64, 120)
+	for i := range userIDs {
+		userIDs[i] = int64(i + 1)
+	}
+
+	ctx, collector := kickmeta.WithCollector(t.Context())
+
+	// Act
+	_, err := client.User().GetAllUsersByID(ctx, "access-token", userIDs)
+
+	// Assert
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	all := collector.All()
+	if len(all) != 3 {
+		t.Fatalf("Expected 3 recorded responses, got %d", len(all))
+	}
+
+	for _, meta := range all {
+		if meta.Operation != "User.GetAllUsersByID" {
+			t.Fatalf("Expected operation User.GetAllUsersByID, got %q", meta.Operation)
+		}
+	}
+}
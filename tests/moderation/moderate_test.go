@@ -0,0 +1,130 @@
+package kick_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/henrikah/kick-go-sdk/v2"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/kickmoderation"
+	"github.com/henrikah/kick-go-sdk/v2/tests/mocks"
+)
+
+func Test_ModerateTimeout_Success(t *testing.T) {
+	// Arrange
+	accessToken := "access-token"
+	var moderationRequest kickapitypes.ModerationRequest
+
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if req.URL.String() != "https://api.kick.com/public/v1/moderation/bans" || req.Method != http.MethodPost {
+				t.Fatalf("Unexpected request %s %s", req.Method, req.URL.String())
+			}
+			if err := json.NewDecoder(req.Body).Decode(&moderationRequest); err != nil {
+				t.Fatal(err)
+			}
+			return mocks.NewMockResponse(http.StatusOK, `{"data": {}, "message": "OK"}`), nil
+		},
+	}
+
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	request := kickmoderation.NewTimeout(1, 2, 90*time.Second).WithReason("spam")
+
+	// Act
+	moderationResponse, err := client.Moderation().Moderate(t.Context(), accessToken, request)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if moderationResponse == nil || moderationResponse.Message != "OK" {
+		t.Fatalf("Expected a moderation response, got %+v", moderationResponse)
+	}
+
+	if moderationRequest.Duration == nil || *moderationRequest.Duration != 2 {
+		t.Fatalf("Expected 90 seconds to round up to 2 minutes, got %+v", moderationRequest.Duration)
+	}
+
+	if moderationRequest.Reason == nil || *moderationRequest.Reason != "spam" || moderationRequest.BroadcasterUserID != 1 || moderationRequest.UserID != 2 {
+		t.Fatalf("Unexpected request body %+v", moderationRequest)
+	}
+}
+
+func Test_ModerateBan_Success(t *testing.T) {
+	// Arrange
+	var body map[string]any
+
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+				t.Fatal(err)
+			}
+			return mocks.NewMockResponse(http.StatusOK, `{"data": {}, "message": "OK"}`), nil
+		},
+	}
+
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+
+	// Act
+	_, err := client.Moderation().Moderate(t.Context(), "access-token", kickmoderation.NewBan(1, 2))
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if _, ok := body["duration"]; ok {
+		t.Fatalf("Expected a ban without duration, got %v", body)
+	}
+	if _, ok := body["reason"]; ok {
+		t.Fatalf("Expected a ban without reason, got %v", body)
+	}
+}
+
+func Test_ModerateTimeoutOutOfRange_Error(t *testing.T) {
+	// Arrange
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: http.DefaultClient})
+
+	for _, duration := range []time.Duration{0, -time.Minute, time.Nanosecond, 59 * time.Second, 7*24*time.Hour + time.Second} {
+		// Act
+		moderationResponse, err := client.Moderation().Moderate(t.Context(), "access-token", kickmoderation.NewTimeout(1, 2, duration))
+
+		// Assert
+		if moderationResponse != nil {
+			t.Fatal("Expected moderationResponse to be nil")
+		}
+
+		validationErr := kickerrors.IsValidationError(err)
+		if validationErr == nil || validationErr.Field != "duration" {
+			t.Fatalf("Expected validation error on field 'duration' for %s, got %v", duration, err)
+		}
+	}
+}
+
+func Test_ModerationMinutes_Success(t *testing.T) {
+	// Arrange
+	cases := map[time.Duration]int{
+		time.Second:               1,
+		time.Minute:               1,
+		90 * time.Second:          2,
+		10 * time.Minute:          10,
+		kickmoderation.MaxTimeout: 10080,
+	}
+
+	for duration, expected := range cases {
+		// Act
+		minutes := kickmoderation.Minutes(duration)
+
+		// Assert
+		if minutes != expected {
+			t.Fatalf("Expected %s to be %d minutes, got %d", duration, expected, minutes)
+		}
+		if err := kickmoderation.ValidateTimeout("duration", duration); duration >= time.Minute && err != nil {
+			t.Fatalf("Expected %s to be a valid timeout, got %v", duration, err)
+		}
+	}
+}
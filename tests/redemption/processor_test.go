@@ -0,0 +1,278 @@
+package kick_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/henrikah/kick-go-sdk/v2"
+	"github.com/henrikah/kick-go-sdk/v2/enums/kickchannelrewardstatus"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/kickredemption"
+	"github.com/henrikah/kick-go-sdk/v2/kickwebhooktypes"
+	"github.com/henrikah/kick-go-sdk/v2/tests/mocks"
+)
+
+func songRequest(ctx context.Context, redemption kickredemption.Redemption) (kickredemption.Decision, error) {
+	switch {
+	case strings.HasPrefix(redemption.UserInput, "https://"):
+		return kickredemption.DecisionAccept, nil
+	case redemption.UserInput == "":
+		return kickredemption.DecisionReject, nil
+	}
+	return kickredemption.DecisionPending, nil
+}
+
+func Test_ProcessorBatchesDecisions_Success(t *testing.T) {
+	// Arrange
+	var accepted, rejected [][]string
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			var body kickapitypes.RedemptionsIDs
+			_ = json.NewDecoder(req.Body).Decode(&body)
+			if strings.HasSuffix(req.URL.Path, "/accept") {
+				accepted = append(accepted, body.IDs)
+			} else {
+				rejected = append(rejected, body.IDs)
+			}
+			return mocks.NewMockResponse(http.StatusOK, `{"data":[],"message":"OK"}`), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	processor, _ := kickredemption.NewProcessor(kickredemption.ProcessorConfig{
+		ChannelReward: client.ChannelReward(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "broadcaster-token", nil
+		},
+		MaxAttempts: 2,
+	})
+	_ = processor.HandleTitle("Song Request", songRequest)
+
+	for i := range 30 {
+		_, err := processor.Process(t.Context(), kickredemption.Redemption{
+			ID:                fmt.Sprintf("redemption-%d", i),
+			BroadcasterUserID: 1,
+			RewardTitle:       "song request",
+			UserInput:         "https://example.com/song",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	decision, _ := processor.Process(t.Context(), kickredemption.Redemption{ID: "empty", BroadcasterUserID: 1, RewardTitle: "Song Request"})
+	repeated, _ := processor.Process(t.Context(), kickredemption.Redemption{ID: "empty", BroadcasterUserID: 1, RewardTitle: "Song Request"})
+	unhandled, _ := processor.Process(t.Context(), kickredemption.Redemption{ID: "other", BroadcasterUserID: 1, RewardTitle: "Hydrate"})
+
+	// Act
+	err := processor.Flush(t.Context())
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if decision != kickredemption.DecisionReject || repeated != kickredemption.DecisionPending || unhandled != kickredemption.DecisionPending {
+		t.Fatalf("Unexpected decisions %s, %s and %s", decision, repeated, unhandled)
+	}
+
+	if len(accepted) != 2 || len(accepted[0]) != 25 || len(accepted[1]) != 5 {
+		t.Fatalf("Expected 30 accepts in batches of 25 and 5, got %v", accepted)
+	}
+
+	if len(rejected) != 1 || len(rejected[0]) != 1 || rejected[0][0] != "empty" {
+		t.Fatalf("Expected 1 reject, got %v", rejected)
+	}
+}
+
+func Test_ProcessorRetriesFailedDecisions_Error(t *testing.T) {
+	// Arrange
+	var accepted [][]string
+	failures := 3
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if failures > 0 {
+				failures--
+				return mocks.NewMockResponse(http.StatusInternalServerError, `{"message":"try again"}`), nil
+			}
+
+			var body kickapitypes.RedemptionsIDs
+			_ = json.NewDecoder(req.Body).Decode(&body)
+			accepted = append(accepted, body.IDs)
+			return mocks.NewMockResponse(http.StatusOK, `{"data":[],"message":"OK"}`), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	processor, _ := kickredemption.NewProcessor(kickredemption.ProcessorConfig{
+		ChannelReward: client.ChannelReward(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "broadcaster-token", nil
+		},
+		MaxAttempts: 2,
+	})
+	_ = processor.HandleTitle("Song Request", songRequest)
+	_, _ = processor.Process(t.Context(), kickredemption.Redemption{ID: "a", BroadcasterUserID: 1, RewardTitle: "Song Request", UserInput: "https://a"})
+
+	// Act
+	firstErr := processor.Flush(t.Context())
+	secondErr := processor.Flush(t.Context())
+	thirdErr := processor.Flush(t.Context())
+
+	// Assert
+	if firstErr != nil {
+		t.Fatalf("Expected the first failure to be retried, got %v", firstErr)
+	}
+
+	if secondErr == nil || !strings.Contains(secondErr.Error(), "a") {
+		t.Fatalf("Expected the decision to be dropped after 2 attempts, got %v", secondErr)
+	}
+
+	if thirdErr != nil || len(accepted) != 0 {
+		t.Fatalf("Expected nothing left to send, got %v and %v", thirdErr, accepted)
+	}
+}
+
+func Test_ProcessorUnknownDecision_Error(t *testing.T) {
+	// Arrange
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: http.DefaultClient})
+	processor, _ := kickredemption.NewProcessor(kickredemption.ProcessorConfig{
+		ChannelReward: client.ChannelReward(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "broadcaster-token", nil
+		},
+	})
+	calls := 0
+	_ = processor.HandleTitle("Song Request", func(ctx context.Context, redemption kickredemption.Redemption) (kickredemption.Decision, error) {
+		calls++
+		return "maybe", nil
+	})
+	redemption := kickredemption.Redemption{ID: "a", BroadcasterUserID: 1, RewardTitle: "Song Request"}
+
+	// Act
+	_, firstErr := processor.Process(t.Context(), redemption)
+	_, secondErr := processor.Process(t.Context(), redemption)
+
+	// Assert
+	if kickerrors.IsValidationError(firstErr) == nil || kickerrors.IsValidationError(secondErr) == nil {
+		t.Fatalf("Expected validation errors, got %v and %v", firstErr, secondErr)
+	}
+
+	if calls != 2 {
+		t.Fatalf("Expected the redemption to be handled again, got %d calls", calls)
+	}
+}
+
+func Test_ProcessorPoll_Success(t *testing.T) {
+	// Arrange
+	var (
+		queries            []string
+		accepted, rejected [][]string
+	)
+	pages := []string{
+		`{"data":[{"reward":{"id":"reward-1","title":"Song Request"},"redemptions":[
+			{"id":"r1","status":"pending","user_input":"https://song","redeemer":{"broadcaster_user_id":7}},
+			{"id":"r2","status":"pending","user_input":"later"}
+		]}],"message":"OK","pagination":{"next_cursor":"next"}}`,
+		`{"data":[{"reward":{"id":"reward-1","title":"Song Request"},"redemptions":[
+			{"id":"r3","status":"pending","user_input":""}
+		]}],"message":"OK","pagination":{"next_cursor":""}}`,
+	}
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if req.Method == http.MethodGet {
+				queries = append(queries, req.URL.RawQuery)
+				page := pages[0]
+				if req.URL.Query().Get("cursor") != "" {
+					page = pages[1]
+				}
+				return mocks.NewMockResponse(http.StatusOK, page), nil
+			}
+
+			var body kickapitypes.RedemptionsIDs
+			_ = json.NewDecoder(req.Body).Decode(&body)
+			if strings.HasSuffix(req.URL.Path, "/accept") {
+				accepted = append(accepted, body.IDs)
+			} else {
+				rejected = append(rejected, body.IDs)
+			}
+			return mocks.NewMockResponse(http.StatusOK, `{"data":[],"message":"OK"}`), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	processor, _ := kickredemption.NewProcessor(kickredemption.ProcessorConfig{
+		ChannelReward: client.ChannelReward(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "broadcaster-token", nil
+		},
+		MaxAttempts: 2,
+	})
+	_ = processor.HandleTitle("Song Request", songRequest)
+
+	// Act
+	pollErr := processor.Poll(t.Context(), 1)
+	flushErr := processor.Flush(t.Context())
+
+	// Assert
+	if pollErr != nil || flushErr != nil {
+		t.Fatalf("Expected no error, got %v and %v", pollErr, flushErr)
+	}
+
+	if len(queries) != 2 || !strings.Contains(queries[0], "status=pending") || !strings.Contains(queries[1], "cursor=next") {
+		t.Fatalf("Expected 2 pages of pending redemptions, got %v", queries)
+	}
+
+	if len(accepted) != 1 || accepted[0][0] != "r1" || len(rejected) != 1 || rejected[0][0] != "r3" {
+		t.Fatalf("Expected r1 accepted and r3 rejected, got %v and %v", accepted, rejected)
+	}
+}
+
+func Test_ProcessorWebhookHandler_Success(t *testing.T) {
+	// Arrange
+	var accepted [][]string
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			var body kickapitypes.RedemptionsIDs
+			_ = json.NewDecoder(req.Body).Decode(&body)
+			accepted = append(accepted, body.IDs)
+			return mocks.NewMockResponse(http.StatusOK, `{"data":[],"message":"OK"}`), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	processor, _ := kickredemption.NewProcessor(kickredemption.ProcessorConfig{
+		ChannelReward: client.ChannelReward(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "broadcaster-token", nil
+		},
+		MaxAttempts: 2,
+	})
+	_ = processor.HandleTitle("Song Request", songRequest)
+	handler := processor.WebhookHandler()
+
+	events := []kickwebhooktypes.ChannelRewardRedemptionUpdated{
+		{ID: "pending", Status: kickchannelrewardstatus.Pending, UserInput: "https://song", Reward: kickwebhooktypes.Reward{Title: "Song Request"}, Broadcaster: kickwebhooktypes.Broadcaster{UserID: 1}},
+		{ID: "accepted", Status: kickchannelrewardstatus.Accepted, UserInput: "https://song", Reward: kickwebhooktypes.Reward{Title: "Song Request"}, Broadcaster: kickwebhooktypes.Broadcaster{UserID: 1}},
+	}
+
+	// Act
+	for _, event := range events {
+		recorder := httptest.NewRecorder()
+		handler(recorder, httptest.NewRequest(http.MethodPost, "/webhook", nil), kickwebhooktypes.KickWebhookHeaders{}, event)
+		if recorder.Code != http.StatusOK {
+			t.Fatalf("Expected status 200, got %d", recorder.Code)
+		}
+	}
+	err := processor.Flush(t.Context())
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(accepted) != 1 || len(accepted[0]) != 1 || accepted[0][0] != "pending" {
+		t.Fatalf("Expected only the pending redemption to be accepted, got %v", accepted)
+	}
+}
@@ -0,0 +1,174 @@
+package kick_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/henrikah/kick-go-sdk/v2"
+	"github.com/henrikah/kick-go-sdk/v2/enums/kickchannelrewardstatus"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/kickredemption"
+	"github.com/henrikah/kick-go-sdk/v2/tests/mocks"
+)
+
+func Test_ReporterReport_Success(t *testing.T) {
+	// Arrange
+	var queries []string
+	pages := map[string]string{
+		"status=accepted": `{"data":[
+			{"reward":{"id":"song","title":"Song Request","cost":500},"redemptions":[
+				{"id":"a1","status":"accepted","redeemed_at":"2025-09-02T10:00:00Z","redeemer":{"broadcaster_user_id":7},"user_input":"=HYPERLINK(\"x\")"},
+				{"id":"old","status":"accepted","redeemed_at":"2025-08-31T23:59:59Z","redeemer":{"broadcaster_user_id":7}}
+			]}
+		],"message":"OK","pagination":{"next_cursor":"page-2"}}`,
+		"cursor=page-2&status=accepted": `{"data":[
+			{"reward":{"id":"song","title":"Song Request","cost":500},"redemptions":[
+				{"id":"a2","status":"accepted","redeemed_at":"2025-09-03T10:00:00Z","redeemer":{"broadcaster_user_id":8}}
+			]},
+			{"reward":{"id":"gone","title":"Old Reward","cost":100,"is_deleted":true},"redemptions":[
+				{"id":"a3","status":"accepted","redeemed_at":"2025-09-01T00:00:00Z","redeemer":{"broadcaster_user_id":7}}
+			]}
+		],"message":"OK","pagination":{"next_cursor":""}}`,
+		"status=rejected": `{"data":[
+			{"reward":{"id":"song","title":"Song Request","cost":500},"redemptions":[
+				{"id":"r1","status":"rejected","redeemed_at":"2025-09-04T10:00:00Z","redeemer":{"broadcaster_user_id":7}},
+				{"id":"next","status":"rejected","redeemed_at":"2025-10-01T00:00:00Z","redeemer":{"broadcaster_user_id":7}}
+			]}
+		],"message":"OK","pagination":{"next_cursor":""}}`,
+	}
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			queries = append(queries, req.URL.RawQuery)
+			page, ok := pages[req.URL.RawQuery]
+			if !ok {
+				page = `{"data":[],"message":"OK","pagination":{"next_cursor":""}}`
+			}
+			return mocks.NewMockResponse(http.StatusOK, page), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	reporter, _ := kickredemption.NewReporter(kickredemption.ReporterConfig{
+		ChannelReward: client.ChannelReward(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "broadcaster-token", nil
+		},
+	})
+	since := time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC)
+
+	// Act
+	report, err := reporter.Report(t.Context(), 1, since, since.AddDate(0, 1, 0))
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(queries) != 4 {
+		t.Fatalf("Expected 4 pages for 3 statuses, got %v", queries)
+	}
+
+	if len(report.Redemptions) != 4 || report.Redemptions[0].ID != "a3" || report.Redemptions[3].ID != "r1" {
+		t.Fatalf("Expected 4 redemptions in the range ordered by time, got %+v", report.Redemptions)
+	}
+
+	expectedTotals := kickredemption.Counts{Total: 4, Accepted: 3, Rejected: 1, Points: 1100}
+	if report.Totals != expectedTotals {
+		t.Fatalf("Expected totals %+v, got %+v", expectedTotals, report.Totals)
+	}
+
+	if len(report.ByReward) != 2 || report.ByReward[0].RewardID != "song" || report.ByReward[0].Total != 3 || !report.ByReward[1].Deleted {
+		t.Fatalf("Unexpected rewards %+v", report.ByReward)
+	}
+
+	if len(report.ByRedeemer) != 2 || report.ByRedeemer[0].UserID != 7 || report.ByRedeemer[0].Total != 3 || report.ByRedeemer[0].Points != 600 {
+		t.Fatalf("Unexpected redeemers %+v", report.ByRedeemer)
+	}
+
+	if rejected := report.ByStatus(kickchannelrewardstatus.Rejected); len(rejected) != 1 || rejected[0].ID != "r1" {
+		t.Fatalf("Expected 1 rejected redemption, got %+v", rejected)
+	}
+}
+
+func Test_ReportExport_Success(t *testing.T) {
+	// Arrange
+	pages := map[string]string{
+		"status=pending": `{"data":[
+			{"reward":{"id":"song","title":"Song Request","cost":500},"redemptions":[
+				{"id":"p1","status":"pending","redeemed_at":"2025-09-02T10:00:00Z","redeemer":{"broadcaster_user_id":7},"user_input":"=cmd|' /C calc'!A0"}
+			]}
+		],"message":"OK","pagination":{"next_cursor":""}}`,
+	}
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			page, ok := pages[req.URL.RawQuery]
+			if !ok {
+				page = `{"data":[],"message":"OK","pagination":{"next_cursor":""}}`
+			}
+			return mocks.NewMockResponse(http.StatusOK, page), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	reporter, _ := kickredemption.NewReporter(kickredemption.ReporterConfig{
+		ChannelReward: client.ChannelReward(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "broadcaster-token", nil
+		},
+	})
+	since := time.Date(2025, time.September, 1, 0, 0, 0, 0, time.UTC)
+	report, err := reporter.Report(t.Context(), 1, since, since.AddDate(0, 1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var csvBuffer, jsonBuffer bytes.Buffer
+
+	// Act
+	csvErr := report.WriteCSV(&csvBuffer)
+	jsonErr := report.WriteJSON(&jsonBuffer)
+
+	// Assert
+	if csvErr != nil || jsonErr != nil {
+		t.Fatalf("Expected no error, got %v and %v", csvErr, jsonErr)
+	}
+
+	lines := strings.Split(strings.TrimSpace(csvBuffer.String()), "\n")
+	if len(lines) != 2 || lines[0] != "redemption_id,redeemed_at,status,reward_id,reward_title,reward_deleted,cost,redeemer_user_id,user_input" {
+		t.Fatalf("Unexpected CSV %q", csvBuffer.String())
+	}
+	if lines[1] != "p1,2025-09-02T10:00:00Z,pending,song,Song Request,false,500,7,'=cmd|' /C calc'!A0" {
+		t.Fatalf("Expected the formula to be escaped, got %q", lines[1])
+	}
+
+	var decoded kickredemption.Report
+	if err := json.Unmarshal(jsonBuffer.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Totals.Pending != 1 || decoded.ByReward[0].Points != 500 || decoded.Redemptions[0].UserInput != "=cmd|' /C calc'!A0" {
+		t.Fatalf("Unexpected JSON report %+v", decoded)
+	}
+}
+
+func Test_ReporterInvalidRange_Error(t *testing.T) {
+	// Arrange
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: http.DefaultClient})
+	reporter, _ := kickredemption.NewReporter(kickredemption.ReporterConfig{
+		ChannelReward: client.ChannelReward(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "broadcaster-token", nil
+		},
+	})
+	now := time.Now()
+
+	// Act
+	report, err := reporter.Report(t.Context(), 1, now, now)
+
+	// Assert
+	if report != nil || kickerrors.IsValidationError(err) == nil {
+		t.Fatalf("Expected a validation error, got %v", err)
+	}
+}
@@ -0,0 +1,203 @@
+package kick_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/henrikah/kick-go-sdk/v2"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/kickrewardsync"
+	"github.com/henrikah/kick-go-sdk/v2/tests/mocks"
+)
+
+func testCatalog(rewards ...kickrewardsync.Reward) kickrewardsync.Catalog {
+	return kickrewardsync.Catalog{Channels: []kickrewardsync.Channel{{BroadcasterUserID: 1, Rewards: rewards}}}
+}
+
+func Test_SyncerPlan_Success(t *testing.T) {
+	// Arrange
+	var calls []string
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if req.Method != http.MethodGet {
+				calls = append(calls, req.Method+" "+req.URL.Path)
+			}
+			return mocks.NewMockResponse(http.StatusOK, `{"data":[
+				{"id":"hydrate","title":"hydrate","cost":100,"is_enabled":true},
+				{"id":"manual","title":"Dashboard reward","cost":50,"is_enabled":true}
+			],"message":"OK"}`), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	syncer, _ := kickrewardsync.NewSyncer(kickrewardsync.SyncerConfig{
+		ChannelReward: client.ChannelReward(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "broadcaster-token", nil
+		},
+		Store:  nil,
+		DryRun: true,
+	})
+	catalog := testCatalog(
+		kickrewardsync.Reward{Title: "Hydrate", Cost: 200, IsPaused: true},
+		kickrewardsync.Reward{Key: "song", Title: "Song Request", Cost: 500, IsUserInputRequired: true},
+	)
+
+	// Act
+	plan, err := syncer.Sync(t.Context(), catalog)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(calls) != 0 {
+		t.Fatalf("Expected a dry run to change nothing, got %v", calls)
+	}
+
+	if len(plan.Changes) != 2 || plan.Unmanaged != 1 || plan.Unchanged != 0 {
+		t.Fatalf("Expected 2 changes and 1 unmanaged reward, got %+v", plan)
+	}
+
+	update, create := plan.Changes[0], plan.Changes[1]
+	if update.String() != `update "Hydrate" in channel 1 (title, cost, is_paused)` || update.RewardID != "hydrate" {
+		t.Fatalf("Unexpected update %s", update)
+	}
+	if create.Type != kickrewardsync.ChangeCreate || create.Key != "song" {
+		t.Fatalf("Unexpected create %s", create)
+	}
+}
+
+func Test_SyncerApply_Success(t *testing.T) {
+	// Arrange
+	var calls []string
+	rewards := []kickapitypes.ChannelRewardData{
+		{ID: "manual", Title: "Dashboard reward", Cost: 50, IsEnabled: true},
+	}
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			id := path.Base(req.URL.Path)
+			switch req.Method {
+			case http.MethodGet:
+				body, _ := json.Marshal(kickapitypes.ChannelRewards{Data: rewards, Message: "OK"})
+				return mocks.NewMockResponse(http.StatusOK, string(body)), nil
+			case http.MethodPost:
+				var create kickapitypes.CreateChannelReward
+				_ = json.NewDecoder(req.Body).Decode(&create)
+				reward := kickapitypes.ChannelRewardData{ID: fmt.Sprintf("new-%d", len(calls)+1), Title: create.Title, Cost: create.Cost, IsEnabled: *create.IsEnabled}
+				rewards = append(rewards, reward)
+				calls = append(calls, "POST "+id)
+				body, _ := json.Marshal(kickapitypes.ChannelReward{Data: reward, Message: "OK"})
+				return mocks.NewMockResponse(http.StatusOK, string(body)), nil
+			case http.MethodPatch:
+				var update kickapitypes.UpdateChannelReward
+				_ = json.NewDecoder(req.Body).Decode(&update)
+				calls = append(calls, "PATCH "+id)
+				for i := range rewards {
+					if rewards[i].ID == id && update.Title != nil {
+						rewards[i].Title = *update.Title
+					}
+				}
+				return mocks.NewMockResponse(http.StatusOK, `{"data":{},"message":"OK"}`), nil
+			case http.MethodDelete:
+				calls = append(calls, "DELETE "+id)
+				rewards = slices.DeleteFunc(rewards, func(reward kickapitypes.ChannelRewardData) bool {
+					return reward.ID == id
+				})
+				return mocks.NewMockResponse(http.StatusNoContent, ""), nil
+			}
+			return mocks.NewMockResponse(http.StatusNotFound, `{"message":"not found"}`), nil
+		},
+	}
+	store := kickrewardsync.NewFileStore(filepath.Join(t.TempDir(), "state.json"))
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	syncer, _ := kickrewardsync.NewSyncer(kickrewardsync.SyncerConfig{
+		ChannelReward: client.ChannelReward(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "broadcaster-token", nil
+		},
+		Store:  store,
+		DryRun: false,
+	})
+
+	// Act
+	_, firstErr := syncer.Sync(t.Context(), testCatalog(
+		kickrewardsync.Reward{Key: "song", Title: "Song Request", Cost: 500},
+		kickrewardsync.Reward{Key: "hydrate", Title: "Hydrate", Cost: 100, IsPaused: true},
+	))
+	renamed, secondErr := syncer.Sync(t.Context(), testCatalog(
+		kickrewardsync.Reward{Key: "song", Title: "Song Requests", Cost: 500},
+	))
+	again, thirdErr := syncer.Sync(t.Context(), testCatalog(
+		kickrewardsync.Reward{Key: "song", Title: "Song Requests", Cost: 500},
+	))
+
+	// Assert
+	if firstErr != nil || secondErr != nil || thirdErr != nil {
+		t.Fatalf("Expected no error, got %v, %v and %v", firstErr, secondErr, thirdErr)
+	}
+
+	expectedCalls := []string{"POST rewards", "POST rewards", "PATCH new-2", "DELETE new-2", "PATCH new-1"}
+	if !slices.Equal(calls, expectedCalls) {
+		t.Fatalf("Expected calls %v, got %v", expectedCalls, calls)
+	}
+
+	if len(renamed.Changes) != 2 || renamed.Changes[0].Type != kickrewardsync.ChangeDelete || renamed.Changes[1].Fields[0] != "title" {
+		t.Fatalf("Expected the removed reward deleted and the renamed reward updated, got %v", renamed.Changes)
+	}
+
+	if len(again.Changes) != 0 || again.Unchanged != 1 || again.Unmanaged != 1 {
+		t.Fatalf("Expected nothing left to change, got %+v", again)
+	}
+
+	if len(rewards) != 2 || rewards[0].ID != "manual" {
+		t.Fatalf("Expected the unmanaged reward to be kept, got %+v", rewards)
+	}
+}
+
+func Test_CatalogValidate_Error(t *testing.T) {
+	// Arrange
+	cases := map[string]kickrewardsync.Catalog{
+		"Channels[0].Rewards[0].BackgroundColor": testCatalog(kickrewardsync.Reward{Title: "Hydrate", Cost: 1, BackgroundColor: "green"}),
+		"Channels[0].Rewards[0].Title":           testCatalog(kickrewardsync.Reward{Title: strings.Repeat("a", 51), Cost: 1}),
+		"Channels[0].Rewards[0].Description":     testCatalog(kickrewardsync.Reward{Title: "Hydrate", Cost: 1, Description: strings.Repeat("a", 201)}),
+		"Channels[0].Rewards[1].Title":           testCatalog(kickrewardsync.Reward{Title: "Hydrate", Cost: 1}, kickrewardsync.Reward{Key: "other", Title: "HYDRATE", Cost: 1}),
+		"Channels[0].Rewards[0].Cost":            testCatalog(kickrewardsync.Reward{Title: "Hydrate"}),
+	}
+
+	for field, catalog := range cases {
+		// Act
+		err := catalog.Validate()
+
+		// Assert
+		validationErr := kickerrors.IsValidationError(err)
+		if validationErr == nil || validationErr.Field != field {
+			t.Fatalf("Expected validation error on field '%s', got %v", field, err)
+		}
+	}
+}
+
+func Test_ReadCatalogJSON_Success(t *testing.T) {
+	// Arrange
+	input := `{"channels":[{"broadcaster_user_id":1,"rewards":[{"key":"song","title":"Song Request","cost":500,"background_color":"#00E701","is_enabled":false}]}]}`
+
+	// Act
+	catalog, err := kickrewardsync.ReadJSON(strings.NewReader(input))
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	reward := catalog.Channels[0].Rewards[0]
+	if reward.Key != "song" || reward.BackgroundColor != "#00E701" || reward.IsEnabled == nil || *reward.IsEnabled {
+		t.Fatalf("Unexpected reward %+v", reward)
+	}
+}
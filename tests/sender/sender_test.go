@@ -0,0 +1,519 @@
+package kick_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/henrikah/kick-go-sdk/v2"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/kicksender"
+	"github.com/henrikah/kick-go-sdk/v2/tests/mocks"
+)
+
+func Test_SplitWordBoundaries_Success(t *testing.T) {
+	// Arrange
+	text := strings.Repeat("hello wörld ", 10)
+
+	// Act
+	parts, err := kicksender.Split(text, 30)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(parts) < 2 {
+		t.Fatalf("Expected several parts, got %q", parts)
+	}
+
+	for i, part := range parts {
+		if utf8.RuneCountInString(part) > 30 {
+			t.Fatalf("Expected at most 30 characters, got %d in %q", utf8.RuneCountInString(part), part)
+		}
+
+		suffix := fmt.Sprintf(" (%d/%d)", i+1, len(parts))
+		if !strings.HasSuffix(part, suffix) {
+			t.Fatalf("Expected part to end with %q, got %q", suffix, part)
+		}
+
+		words := strings.Fields(strings.TrimSuffix(part, suffix))
+		for _, word := range words {
+			if word != "hello" && word != "wörld" {
+				t.Fatalf("Expected words not to be broken, got %q", word)
+			}
+		}
+	}
+}
+
+func Test_SplitShortText_Success(t *testing.T) {
+	// Arrange
+	text := "short message"
+
+	// Act
+	parts, err := kicksender.Split(text, 500)
+
+	// Assert
+	if err != nil || len(parts) != 1 || parts[0] != "short message" {
+		t.Fatalf("Expected the message unchanged, got %q", parts)
+	}
+}
+
+func Test_SenderTooManyParts_Error(t *testing.T) {
+	// Arrange
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: http.DefaultClient})
+	sender, _ := kicksender.NewSender(kicksender.SenderConfig{
+		Chat: client.Chat(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "bot-token", nil
+		},
+		MaxLength: 10,
+	})
+	defer sender.Close()
+
+	// Act
+	receipt, err := sender.Enqueue(kicksender.Message{BroadcasterUserID: 1, Content: strings.Repeat("a", 400)})
+
+	// Assert
+	if !errors.Is(err, kicksender.ErrTooManyParts) {
+		t.Fatalf("Expected ErrTooManyParts, got %v", err)
+	}
+
+	if receipt != nil {
+		t.Fatal("Expected the message not to be queued")
+	}
+}
+
+func Test_NewSenderMaxLength_Error(t *testing.T) {
+	// Arrange
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: http.DefaultClient})
+
+	// Act
+	sender, err := kicksender.NewSender(kicksender.SenderConfig{
+		Chat: client.Chat(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "bot-token", nil
+		},
+		MaxLength: 501,
+	})
+
+	// Assert
+	if validationErr := kickerrors.IsValidationError(err); validationErr == nil || validationErr.Field != "MaxLength" {
+		t.Fatalf("Expected validation error on field 'MaxLength', got %v", err)
+	}
+
+	if sender != nil {
+		t.Fatal("Expected no sender")
+	}
+}
+
+func Test_SenderSendSplitMessage_Success(t *testing.T) {
+	// Arrange
+	var (
+		mu       sync.Mutex
+		requests []kickapitypes.SendChatRequest
+	)
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			var chatRequest kickapitypes.SendChatRequest
+			_ = json.NewDecoder(req.Body).Decode(&chatRequest)
+
+			mu.Lock()
+			defer mu.Unlock()
+			requests = append(requests, chatRequest)
+			return mocks.NewMockResponse(http.StatusOK, fmt.Sprintf(`{"data":{"is_sent":true,"message_id":"message-%d"},"message":"OK"}`, len(requests))), nil
+		},
+	}
+
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	sender, _ := kicksender.NewSender(kicksender.SenderConfig{
+		Chat: client.Chat(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "bot-token", nil
+		},
+		MessagesPerInterval: 10,
+		MaxLength:           20,
+		Logger:              slog.New(slog.DiscardHandler),
+	})
+	defer sender.Close()
+	replyTo := "original-id"
+
+	// Act
+	deliveries, err := sender.Send(t.Context(), kicksender.Message{
+		BroadcasterUserID: 1,
+		Content:           "one two three four five six seven",
+		ReplyToMessageID:  &replyTo,
+	})
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if len(deliveries) != 3 {
+		t.Fatalf("Expected 3 deliveries, got %+v", deliveries)
+	}
+
+	for i, delivery := range deliveries {
+		if delivery.MessageID != fmt.Sprintf("message-%d", i+1) || !delivery.Sent || delivery.Part != i+1 || delivery.Parts != 3 {
+			t.Fatalf("Expected delivery %d to report its message ID, got %+v", i+1, delivery)
+		}
+	}
+
+	for _, request := range requests {
+		if request.ReplyToMessageID == nil || *request.ReplyToMessageID != replyTo {
+			t.Fatalf("Expected every part to reply to the original message, got %+v", request)
+		}
+	}
+}
+
+func Test_SenderPacing_Success(t *testing.T) {
+	// Arrange
+	interval := 50 * time.Millisecond
+	var (
+		mu       sync.Mutex
+		requests []kickapitypes.SendChatRequest
+		times    []time.Time
+	)
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			var chatRequest kickapitypes.SendChatRequest
+			_ = json.NewDecoder(req.Body).Decode(&chatRequest)
+
+			mu.Lock()
+			defer mu.Unlock()
+			requests = append(requests, chatRequest)
+			times = append(times, time.Now())
+			return mocks.NewMockResponse(http.StatusOK, fmt.Sprintf(`{"data":{"is_sent":true,"message_id":"message-%d"},"message":"OK"}`, len(requests))), nil
+		},
+	}
+
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	sender, _ := kicksender.NewSender(kicksender.SenderConfig{
+		Chat: client.Chat(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "bot-token", nil
+		},
+		MessagesPerInterval: 2,
+		Interval:            interval,
+		Logger:              slog.New(slog.DiscardHandler),
+	})
+	defer sender.Close()
+
+	// Act
+	var receipts []*kicksender.Receipt
+	for i := range 4 {
+		receipt, err := sender.Enqueue(kicksender.Message{BroadcasterUserID: 1, Content: fmt.Sprintf("message %d", i)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		receipts = append(receipts, receipt)
+	}
+	for _, receipt := range receipts {
+		if _, err := receipt.Wait(t.Context()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Assert
+	if len(times) != 4 {
+		t.Fatalf("Expected 4 messages, got %d", len(times))
+	}
+
+	if elapsed := times[2].Sub(times[0]); elapsed < interval {
+		t.Fatalf("Expected the third message to wait for the interval, got %v", elapsed)
+	}
+}
+
+func Test_SenderPriority_Success(t *testing.T) {
+	// Arrange
+	var (
+		mu       sync.Mutex
+		requests []kickapitypes.SendChatRequest
+	)
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			var chatRequest kickapitypes.SendChatRequest
+			_ = json.NewDecoder(req.Body).Decode(&chatRequest)
+
+			mu.Lock()
+			defer mu.Unlock()
+			requests = append(requests, chatRequest)
+			return mocks.NewMockResponse(http.StatusOK, fmt.Sprintf(`{"data":{"is_sent":true,"message_id":"message-%d"},"message":"OK"}`, len(requests))), nil
+		},
+	}
+
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	sender, _ := kicksender.NewSender(kicksender.SenderConfig{
+		Chat: client.Chat(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "bot-token", nil
+		},
+		Interval: 50 * time.Millisecond,
+		Logger:   slog.New(slog.DiscardHandler),
+	})
+	defer sender.Close()
+
+	// Act
+	first, _ := sender.Enqueue(kicksender.Message{BroadcasterUserID: 1, Content: "first"})
+	_, _ = first.Wait(t.Context())
+	_, _ = sender.Enqueue(kicksender.Message{BroadcasterUserID: 1, Content: "announcement", Priority: kicksender.PriorityLow})
+	_, _ = sender.Enqueue(kicksender.Message{BroadcasterUserID: 1, Content: "reply"})
+	last, _ := sender.Enqueue(kicksender.Message{BroadcasterUserID: 1, Content: "user was timed out", Priority: kicksender.PriorityHigh})
+	_, _ = last.Wait(t.Context())
+	_, _ = sender.Send(t.Context(), kicksender.Message{BroadcasterUserID: 1, Content: "done", Priority: kicksender.PriorityLow})
+
+	// Assert
+	expected := []string{"first", "user was timed out", "reply", "announcement", "done"}
+	if len(requests) != len(expected) {
+		t.Fatalf("Expected %d messages, got %+v", len(expected), requests)
+	}
+
+	for i, request := range requests {
+		if request.Content != expected[i] {
+			t.Fatalf("Expected message %d to be %q, got %q", i+1, expected[i], request.Content)
+		}
+	}
+}
+
+func Test_SenderDuplicate_Success(t *testing.T) {
+	// Arrange
+	var (
+		mu       sync.Mutex
+		requests []kickapitypes.SendChatRequest
+	)
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			var chatRequest kickapitypes.SendChatRequest
+			_ = json.NewDecoder(req.Body).Decode(&chatRequest)
+
+			mu.Lock()
+			defer mu.Unlock()
+			requests = append(requests, chatRequest)
+			return mocks.NewMockResponse(http.StatusOK, fmt.Sprintf(`{"data":{"is_sent":true,"message_id":"message-%d"},"message":"OK"}`, len(requests))), nil
+		},
+	}
+
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	sender, _ := kicksender.NewSender(kicksender.SenderConfig{
+		Chat: client.Chat(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "bot-token", nil
+		},
+		MessagesPerInterval: 10,
+		Logger:              slog.New(slog.DiscardHandler),
+	})
+	defer sender.Close()
+
+	// Act
+	first, _ := sender.Enqueue(kicksender.Message{BroadcasterUserID: 1, Content: "Welcome!"})
+	duplicate, _ := sender.Enqueue(kicksender.Message{BroadcasterUserID: 1, Content: "Welcome!"})
+	otherChannel, _ := sender.Enqueue(kicksender.Message{BroadcasterUserID: 2, Content: "Welcome!"})
+	_, _ = first.Wait(t.Context())
+	deliveries, err := duplicate.Wait(t.Context())
+	_, _ = otherChannel.Wait(t.Context())
+
+	// Assert
+	if err != nil || len(deliveries) != 0 || !duplicate.Duplicate() {
+		t.Fatalf("Expected the duplicate to be dropped, got %+v and %v", deliveries, err)
+	}
+
+	if first.Duplicate() || otherChannel.Duplicate() {
+		t.Fatal("Expected only the repeated message of the same channel to be dropped")
+	}
+
+	if len(requests) != 2 {
+		t.Fatalf("Expected 2 messages, got %+v", requests)
+	}
+}
+
+func Test_SenderDuplicateOtherTarget_Success(t *testing.T) {
+	// Arrange
+	var (
+		mu       sync.Mutex
+		requests []kickapitypes.SendChatRequest
+	)
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			var chatRequest kickapitypes.SendChatRequest
+			_ = json.NewDecoder(req.Body).Decode(&chatRequest)
+
+			mu.Lock()
+			defer mu.Unlock()
+			requests = append(requests, chatRequest)
+			return mocks.NewMockResponse(http.StatusOK, fmt.Sprintf(`{"data":{"is_sent":true,"message_id":"message-%d"},"message":"OK"}`, len(requests))), nil
+		},
+	}
+
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	sender, _ := kicksender.NewSender(kicksender.SenderConfig{
+		Chat: client.Chat(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "bot-token", nil
+		},
+		MessagesPerInterval: 10,
+		Logger:              slog.New(slog.DiscardHandler),
+	})
+	defer sender.Close()
+	firstCommand, secondCommand := "command-1", "command-2"
+
+	// Act
+	receipts := make([]*kicksender.Receipt, 0, 3)
+	for _, message := range []kicksender.Message{
+		{BroadcasterUserID: 1, Content: "done!", ReplyToMessageID: &firstCommand},
+		{BroadcasterUserID: 1, Content: "done!", ReplyToMessageID: &secondCommand},
+		{BroadcasterUserID: 1, Content: "done!", ReplyToMessageID: &secondCommand, AsUser: true},
+	} {
+		receipt, err := sender.Enqueue(message)
+		if err != nil {
+			t.Fatal(err)
+		}
+		receipts = append(receipts, receipt)
+	}
+	for _, receipt := range receipts {
+		_, _ = receipt.Wait(t.Context())
+	}
+
+	// Assert
+	for i, receipt := range receipts {
+		if receipt.Duplicate() {
+			t.Fatalf("Expected message %d with another reply target or sender to not be a duplicate", i+1)
+		}
+	}
+
+	if len(requests) != 3 {
+		t.Fatalf("Expected 3 messages, got %+v", requests)
+	}
+}
+
+func Test_SenderCloseWaitsForInFlightSend_Success(t *testing.T) {
+	// Arrange
+	started := make(chan struct{})
+	release := make(chan struct{})
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			close(started)
+			<-release
+			if err := req.Context().Err(); err != nil {
+				return nil, err
+			}
+			return mocks.NewMockResponse(http.StatusOK, `{"data":{"is_sent":true,"message_id":"message-1"},"message":"OK"}`), nil
+		},
+	}
+
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	sender, _ := kicksender.NewSender(kicksender.SenderConfig{
+		Chat: client.Chat(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "bot-token", nil
+		},
+		Logger: slog.New(slog.DiscardHandler),
+	})
+	receipt, _ := sender.Enqueue(kicksender.Message{BroadcasterUserID: 1, Content: "in flight"})
+	<-started
+
+	// Act
+	closed := make(chan struct{})
+	go func() {
+		sender.Close()
+		close(closed)
+	}()
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	<-closed
+	deliveries, err := receipt.Wait(t.Context())
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected the in-flight send to finish, got %v", err)
+	}
+
+	if len(deliveries) != 1 || !deliveries[0].Sent {
+		t.Fatalf("Expected 1 delivery, got %+v", deliveries)
+	}
+}
+
+func Test_SenderClose_Error(t *testing.T) {
+	// Arrange
+	var (
+		mu       sync.Mutex
+		requests []kickapitypes.SendChatRequest
+	)
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			var chatRequest kickapitypes.SendChatRequest
+			_ = json.NewDecoder(req.Body).Decode(&chatRequest)
+
+			mu.Lock()
+			defer mu.Unlock()
+			requests = append(requests, chatRequest)
+			return mocks.NewMockResponse(http.StatusOK, fmt.Sprintf(`{"data":{"is_sent":true,"message_id":"message-%d"},"message":"OK"}`, len(requests))), nil
+		},
+	}
+
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	sender, _ := kicksender.NewSender(kicksender.SenderConfig{
+		Chat: client.Chat(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "bot-token", nil
+		},
+		Interval: time.Hour,
+		Logger:   slog.New(slog.DiscardHandler),
+	})
+	defer sender.Close()
+
+	_, _ = sender.Send(t.Context(), kicksender.Message{BroadcasterUserID: 1, Content: "first"})
+	queued, _ := sender.Enqueue(kicksender.Message{BroadcasterUserID: 1, Content: "second"})
+
+	// Act
+	sender.Close()
+	_, queuedErr := queued.Wait(t.Context())
+	_, enqueueErr := sender.Enqueue(kicksender.Message{BroadcasterUserID: 1, Content: "third"})
+
+	// Assert
+	if !errors.Is(queuedErr, kicksender.ErrClosed) {
+		t.Fatalf("Expected ErrClosed for the queued message, got %v", queuedErr)
+	}
+
+	if !errors.Is(enqueueErr, kicksender.ErrClosed) {
+		t.Fatalf("Expected ErrClosed after closing, got %v", enqueueErr)
+	}
+}
+
+func Test_SenderBlankContent_Error(t *testing.T) {
+	// Arrange
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: http.DefaultClient})
+	sender, _ := kicksender.NewSender(kicksender.SenderConfig{
+		Chat: client.Chat(),
+		AccessToken: func(ctx context.Context, broadcasterUserID int) (string, error) {
+			return "bot-token", nil
+		},
+	})
+	defer sender.Close()
+
+	// Act
+	receipt, err := sender.Enqueue(kicksender.Message{BroadcasterUserID: 1, Content: " \n\t "})
+	parts, splitErr := kicksender.Split(" \n\t ", 500)
+
+	// Assert
+	if kickerrors.IsValidationError(err) == nil {
+		t.Fatalf("Expected validation error, got %v", err)
+	}
+
+	if receipt != nil {
+		t.Fatal("Expected the blank message not to be queued")
+	}
+
+	if splitErr != nil || len(parts) != 0 {
+		t.Fatalf("Expected no parts for blank text, got %q and %v", parts, splitErr)
+	}
+}
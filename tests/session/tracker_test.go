@@ -0,0 +1,279 @@
+package kick_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/henrikah/kick-go-sdk/v2"
+	"github.com/henrikah/kick-go-sdk/v2/kicksession"
+	"github.com/henrikah/kick-go-sdk/v2/kicktime"
+	"github.com/henrikah/kick-go-sdk/v2/kickwebhooktypes"
+)
+
+var streamStart = time.Date(2025, time.September, 1, 18, 0, 0, 0, time.UTC)
+
+func broadcaster() kickwebhooktypes.User {
+	return kickwebhooktypes.User{UserID: 1, Username: "streamer"}
+}
+
+func metadata(title string, categoryID int, categoryName string) kickwebhooktypes.LivestreamMetadataUpdated {
+	return kickwebhooktypes.LivestreamMetadataUpdated{
+		Broadcaster: broadcaster(),
+		Metadata: kickwebhooktypes.LivestreamMetadata{
+			Title:    title,
+			Category: kickwebhooktypes.Category{ID: categoryID, Name: categoryName},
+		},
+	}
+}
+
+func Test_TrackerSession_Success(t *testing.T) {
+	// Arrange
+	now := streamStart
+	var summaries []kicksession.Summary
+	tracker, _ := kicksession.NewTracker(kicksession.TrackerConfig{
+		Now: func() time.Time {
+			return now
+		},
+		OnSummary: func(ctx context.Context, summary kicksession.Summary) {
+			summaries = append(summaries, summary)
+		},
+	})
+	ctx := t.Context()
+	gifter := kickwebhooktypes.User{UserID: 7, Username: "gifter"}
+	viewer := kickwebhooktypes.User{UserID: 8, Username: "viewer"}
+
+	// Act
+	errs := []error{
+		tracker.OnChannelFollowed(ctx, "", kickwebhooktypes.ChannelFollowed{Broadcaster: broadcaster()}),
+		tracker.OnLivestreamStatusUpdated(ctx, "", kickwebhooktypes.LivestreamStatusUpdated{Broadcaster: broadcaster(), IsLive: true, Title: "Chatting", StartedAt: kicktime.New(streamStart)}),
+		tracker.OnLivestreamStatusUpdated(ctx, "", kickwebhooktypes.LivestreamStatusUpdated{Broadcaster: broadcaster(), IsLive: true, Title: "Chatting", StartedAt: kicktime.New(streamStart)}),
+		tracker.OnLivestreamMetadataUpdated(ctx, "", metadata("Chatting", 10, "Just Chatting")),
+		tracker.OnChannelFollowed(ctx, "follow-1", kickwebhooktypes.ChannelFollowed{Broadcaster: broadcaster()}),
+		tracker.OnChannelFollowed(ctx, "follow-2", kickwebhooktypes.ChannelFollowed{Broadcaster: broadcaster()}),
+		tracker.OnChannelFollowed(ctx, "follow-2", kickwebhooktypes.ChannelFollowed{Broadcaster: broadcaster()}),
+		tracker.OnChannelSubscriptionNew(ctx, "", kickwebhooktypes.ChannelSubscriptionNew{Broadcaster: broadcaster()}),
+		tracker.OnChannelSubscriptionRenewal(ctx, "", kickwebhooktypes.ChannelSubscriptionRenewal{Broadcaster: broadcaster()}),
+		tracker.OnChannelSubscriptionGifts(ctx, "", kickwebhooktypes.ChannelSubscriptionGifts{Broadcaster: broadcaster(), Gifter: gifter, Giftees: make([]kickwebhooktypes.User, 5)}),
+		tracker.OnChannelSubscriptionGifts(ctx, "", kickwebhooktypes.ChannelSubscriptionGifts{Broadcaster: broadcaster(), Gifter: kickwebhooktypes.User{IsAnonymous: true}, Giftees: make([]kickwebhooktypes.User, 2)}),
+		tracker.OnKicksGifted(ctx, "", kickwebhooktypes.KicksGifted{Broadcaster: broadcaster(), Sender: viewer, Gift: kickwebhooktypes.Gift{Amount: 100}}),
+		tracker.OnKicksGifted(ctx, "", kickwebhooktypes.KicksGifted{Broadcaster: broadcaster(), Sender: gifter, Gift: kickwebhooktypes.Gift{Amount: 50}}),
+		tracker.OnModerationBanned(ctx, "", kickwebhooktypes.ModerationBanned{Broadcaster: broadcaster()}),
+		tracker.OnModerationBanned(ctx, "", kickwebhooktypes.ModerationBanned{Broadcaster: broadcaster(), Metadata: kickwebhooktypes.ModerationBannedMetadata{ExpiresAt: kicktime.New(streamStart.Add(time.Hour))}}),
+	}
+	now = streamStart.Add(90 * time.Minute)
+	errs = append(errs, tracker.OnLivestreamMetadataUpdated(ctx, "", metadata("Slots later", 20, "Slots")))
+	errs = append(errs, tracker.OnLivestreamStatusUpdated(ctx, "", kickwebhooktypes.LivestreamStatusUpdated{Broadcaster: broadcaster(), StartedAt: kicktime.New(streamStart), EndedAt: kicktime.New(streamStart.Add(2 * time.Hour))}))
+	errs = append(errs, tracker.OnChannelFollowed(ctx, "", kickwebhooktypes.ChannelFollowed{Broadcaster: broadcaster()}))
+
+	// Assert
+	if err := errors.Join(errs...); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	sessions, err := tracker.Sessions(ctx, 1)
+	if err != nil || len(sessions) != 1 {
+		t.Fatalf("Expected 1 session, got %+v and %v", sessions, err)
+	}
+	session := sessions[0]
+	if session.Live() || !session.EndedAt.Equal(streamStart.Add(2*time.Hour)) || session.Title() != "Slots later" {
+		t.Fatalf("Unexpected session %+v", session)
+	}
+	if len(session.Titles) != 2 || len(session.Categories) != 2 || !session.Categories[0].At.Equal(streamStart) {
+		t.Fatalf("Unexpected timeline %+v and %+v", session.Titles, session.Categories)
+	}
+
+	expectedCounts := kicksession.Counts{Follows: 2, NewSubscriptions: 1, Renewals: 1, GiftedSubscriptions: 7, Kicks: 150, Bans: 1, Timeouts: 1}
+	if session.Counts != expectedCounts {
+		t.Fatalf("Expected counts %+v, got %+v", expectedCounts, session.Counts)
+	}
+
+	if len(summaries) != 1 {
+		t.Fatalf("Expected 1 summary, got %d", len(summaries))
+	}
+	summary := summaries[0]
+	if summary.Duration != 2*time.Hour || summary.Categories[0].CategoryName != "Just Chatting" || summary.Categories[0].Duration != 90*time.Minute {
+		t.Fatalf("Unexpected summary %+v", summary)
+	}
+	if len(summary.TopGifters) != 1 || summary.TopGifters[0].UserID != 7 || len(summary.TopKickSenders) != 2 || summary.TopKickSenders[0].UserID != 8 {
+		t.Fatalf("Unexpected supporters %+v and %+v", summary.TopGifters, summary.TopKickSenders)
+	}
+
+	expectedText := `Stream of 2h0m0s: "Slots later"
+Categories: Just Chatting 1h30m0s, Slots 30m0s
+Follows 2, new subs 1, renewals 1, gifted subs 7, kicks 150, bans 1, timeouts 1
+Top gifters: gifter (5)
+Top kick senders: viewer (100), gifter (50)`
+	if summary.String() != expectedText {
+		t.Fatalf("Expected summary text %q, got %q", expectedText, summary.String())
+	}
+}
+
+func Test_TrackerMissedOffline_Success(t *testing.T) {
+	// Arrange
+	store := kicksession.NewMemoryStore()
+	var summaries []kicksession.Summary
+	tracker, _ := kicksession.NewTracker(kicksession.TrackerConfig{
+		Store: store,
+		OnSummary: func(ctx context.Context, summary kicksession.Summary) {
+			summaries = append(summaries, summary)
+		},
+	})
+	ctx := t.Context()
+	restart := streamStart.Add(3 * time.Hour)
+
+	// Act
+	firstErr := tracker.OnLivestreamStatusUpdated(ctx, "", kickwebhooktypes.LivestreamStatusUpdated{Broadcaster: broadcaster(), IsLive: true, StartedAt: kicktime.New(streamStart)})
+	secondErr := tracker.OnLivestreamStatusUpdated(ctx, "", kickwebhooktypes.LivestreamStatusUpdated{Broadcaster: broadcaster(), IsLive: true, StartedAt: kicktime.New(restart)})
+
+	// Assert
+	if firstErr != nil || secondErr != nil {
+		t.Fatalf("Expected no error, got %v and %v", firstErr, secondErr)
+	}
+
+	sessions, _ := store.Sessions(ctx, 1)
+	if len(sessions) != 2 || !sessions[0].EndedAt.Equal(restart) || !sessions[1].Live() {
+		t.Fatalf("Expected the first session ended at the restart, got %+v", sessions)
+	}
+
+	current, err := tracker.Current(ctx, 1)
+	if err != nil || current == nil || current.ID != sessions[1].ID {
+		t.Fatalf("Expected the second session to be current, got %+v and %v", current, err)
+	}
+
+	if len(summaries) != 1 || summaries[0].SessionID != sessions[0].ID {
+		t.Fatalf("Expected a summary of the first session, got %+v", summaries)
+	}
+}
+
+func Test_TrackerRegister_Success(t *testing.T) {
+	// Arrange
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKeyBytes, _ := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	publicKey := string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyBytes}))
+
+	client, err := kick.NewWebhookClient(publicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tracker, _ := kicksession.NewTracker(kicksession.TrackerConfig{})
+
+	// Act
+	err = tracker.Register(client)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+}
+
+// failingStore is a Store that cannot be reached.
+type failingStore struct {
+	kicksession.MemoryStore
+}
+
+func (s *failingStore) Current(ctx context.Context, broadcasterUserID int) (*kicksession.Session, error) {
+	return nil, errors.New("store unavailable")
+}
+
+func Test_TrackerWebhookHandler_Error(t *testing.T) {
+	// Arrange
+	tracker, _ := kicksession.NewTracker(kicksession.TrackerConfig{Store: &failingStore{}})
+	registrar := &capturingRegistrar{}
+	if err := tracker.Register(registrar); err != nil {
+		t.Fatal(err)
+	}
+	recorder := httptest.NewRecorder()
+
+	// Act
+	registrar.follow(recorder, httptest.NewRequest(http.MethodPost, "/", strings.NewReader("")), kickwebhooktypes.KickWebhookHeaders{}, kickwebhooktypes.ChannelFollowed{Broadcaster: broadcaster()})
+
+	// Assert
+	if recorder.Code != http.StatusInternalServerError {
+		t.Fatalf("Expected status %d so Kick retries, got %d", http.StatusInternalServerError, recorder.Code)
+	}
+}
+
+// capturingRegistrar keeps the follow handler a Tracker registers.
+type capturingRegistrar struct {
+	follow func(http.ResponseWriter, *http.Request, kickwebhooktypes.KickWebhookHeaders, kickwebhooktypes.ChannelFollowed)
+}
+
+func (r *capturingRegistrar) RegisterLivestreamStatusUpdatedHandler(func(http.ResponseWriter, *http.Request, kickwebhooktypes.KickWebhookHeaders, kickwebhooktypes.LivestreamStatusUpdated)) error {
+	return nil
+}
+
+func (r *capturingRegistrar) RegisterLivestreamMetadataUpdatedHandler(func(http.ResponseWriter, *http.Request, kickwebhooktypes.KickWebhookHeaders, kickwebhooktypes.LivestreamMetadataUpdated)) error {
+	return nil
+}
+
+func (r *capturingRegistrar) RegisterChannelFollowedHandler(handler func(http.ResponseWriter, *http.Request, kickwebhooktypes.KickWebhookHeaders, kickwebhooktypes.ChannelFollowed)) error {
+	r.follow = handler
+	return nil
+}
+
+func (r *capturingRegistrar) RegisterChannelSubscriptionNewHandler(func(http.ResponseWriter, *http.Request, kickwebhooktypes.KickWebhookHeaders, kickwebhooktypes.ChannelSubscriptionNew)) error {
+	return nil
+}
+
+func (r *capturingRegistrar) RegisterChannelSubscriptionRenewalHandler(func(http.ResponseWriter, *http.Request, kickwebhooktypes.KickWebhookHeaders, kickwebhooktypes.ChannelSubscriptionRenewal)) error {
+	return nil
+}
+
+func (r *capturingRegistrar) RegisterChannelSubscriptionGiftsHandler(func(http.ResponseWriter, *http.Request, kickwebhooktypes.KickWebhookHeaders, kickwebhooktypes.ChannelSubscriptionGifts)) error {
+	return nil
+}
+
+func (r *capturingRegistrar) RegisterKicksGiftedHandler(func(http.ResponseWriter, *http.Request, kickwebhooktypes.KickWebhookHeaders, kickwebhooktypes.KicksGifted)) error {
+	return nil
+}
+
+func (r *capturingRegistrar) RegisterModerationBannedHandler(func(http.ResponseWriter, *http.Request, kickwebhooktypes.KickWebhookHeaders, kickwebhooktypes.ModerationBanned)) error {
+	return nil
+}
+
+func Test_TrackerSummaryCallsTracker_Success(t *testing.T) {
+	// Arrange
+	var tracker *kicksession.Tracker
+	var followErr error
+	summaries := 0
+	tracker, _ = kicksession.NewTracker(kicksession.TrackerConfig{
+		OnSummary: func(ctx context.Context, summary kicksession.Summary) {
+			summaries++
+			followErr = tracker.OnChannelFollowed(ctx, "follow", kickwebhooktypes.ChannelFollowed{Broadcaster: broadcaster()})
+		},
+	})
+	ctx := t.Context()
+	_ = tracker.OnLivestreamStatusUpdated(ctx, "live", kickwebhooktypes.LivestreamStatusUpdated{Broadcaster: broadcaster(), IsLive: true, StartedAt: kicktime.New(streamStart)})
+
+	// Act
+	done := make(chan error, 1)
+	go func() {
+		done <- tracker.OnLivestreamStatusUpdated(ctx, "offline", kickwebhooktypes.LivestreamStatusUpdated{Broadcaster: broadcaster(), StartedAt: kicktime.New(streamStart), EndedAt: kicktime.New(streamStart.Add(time.Hour))})
+	}()
+
+	// Assert
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected OnSummary to be able to call the tracker")
+	}
+
+	if summaries != 1 || followErr != nil {
+		t.Fatalf("Expected 1 summary recording an event without error, got %d and %v", summaries, followErr)
+	}
+}
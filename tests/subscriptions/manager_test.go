@@ -0,0 +1,457 @@
+package kick_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/henrikah/kick-go-sdk/v2"
+	"github.com/henrikah/kick-go-sdk/v2/enums/kickwebhookenum"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/kicksubscription"
+	"github.com/henrikah/kick-go-sdk/v2/tests/mocks"
+)
+
+func Test_ManagerEnroll_Success(t *testing.T) {
+	// Arrange
+	var subscriptions []kickapitypes.EventSubscriptionData
+	creates := 0
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			switch req.Method {
+			case http.MethodPost:
+				creates++
+				var request kickapitypes.CreateEventSubscriptionRequest
+				_ = json.NewDecoder(req.Body).Decode(&request)
+
+				var response kickapitypes.CreateEventSubscriptionsResponse
+				for _, event := range request.Events {
+					id := fmt.Sprintf("created-%d", creates*10+len(response.Data))
+					subscriptions = append(subscriptions, kickapitypes.EventSubscriptionData{BroadcasterUserID: *request.BroadcasterUserID, Event: event.Name, ID: id, Method: "webhook", Version: event.Version})
+					response.Data = append(response.Data, kickapitypes.CreateEventSubscriptionData{Name: event.Name, Version: event.Version, SubscriptionID: id})
+				}
+				body, _ := json.Marshal(response)
+				return mocks.NewMockResponse(http.StatusOK, string(body)), nil
+			case http.MethodDelete:
+				ids := req.URL.Query()["id"]
+				subscriptions = slices.DeleteFunc(subscriptions, func(data kickapitypes.EventSubscriptionData) bool {
+					return slices.Contains(ids, data.ID)
+				})
+				return mocks.NewMockResponse(http.StatusNoContent, ""), nil
+			}
+			body, _ := json.Marshal(kickapitypes.EventSubscription{Data: subscriptions, Message: "OK"})
+			return mocks.NewMockResponse(http.StatusOK, string(body)), nil
+		},
+	}
+	store := kicksubscription.NewMemoryStore()
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	manager, _ := kicksubscription.NewManager(kicksubscription.ManagerConfig{
+		EventsSubscription: client.EventsSubscription(),
+		Events: []kicksubscription.Event{
+			{Type: kickwebhookenum.ChatMessageSent},
+			{Type: kickwebhookenum.LivestreamStatusUpdated},
+		},
+		Store:          store,
+		CreateInterval: time.Millisecond,
+		VerifyInterval: time.Hour,
+		Logger:         slog.New(slog.DiscardHandler),
+	})
+
+	// Act
+	result, err := manager.Enroll(t.Context(), "app-token", 1)
+	_, againErr := manager.Enroll(t.Context(), "app-token", 1)
+
+	// Assert
+	if err != nil || againErr != nil {
+		t.Fatalf("Expected enrollment to succeed, got %v and %v", err, againErr)
+	}
+
+	if len(result) != 2 {
+		t.Fatalf("Expected 2 subscriptions, got %d", len(result))
+	}
+
+	if creates != 1 {
+		t.Fatalf("Expected enrolling twice to create once, got %d creates", creates)
+	}
+
+	stored, enrolled, _ := store.Load(t.Context(), 1)
+	if !enrolled || len(stored) != 2 {
+		t.Fatalf("Expected the subscription IDs to be stored, got %+v", stored)
+	}
+}
+
+func Test_ManagerEnrollAdoptsExisting_Success(t *testing.T) {
+	// Arrange
+	var created []string
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if req.Method == http.MethodPost {
+				var request kickapitypes.CreateEventSubscriptionRequest
+				_ = json.NewDecoder(req.Body).Decode(&request)
+
+				var response kickapitypes.CreateEventSubscriptionsResponse
+				for _, event := range request.Events {
+					created = append(created, event.Name)
+					response.Data = append(response.Data, kickapitypes.CreateEventSubscriptionData{Name: event.Name, Version: event.Version, SubscriptionID: "created-" + event.Name})
+				}
+				body, _ := json.Marshal(response)
+				return mocks.NewMockResponse(http.StatusOK, string(body)), nil
+			}
+			return mocks.NewMockResponse(http.StatusOK, `{"data": [
+				{"id": "existing", "broadcaster_user_id": 1, "event": "chat.message.sent", "version": 1},
+				{"id": "other", "broadcaster_user_id": 2, "event": "livestream.status.updated", "version": 1}
+			], "message": "OK"}`), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	manager, _ := kicksubscription.NewManager(kicksubscription.ManagerConfig{
+		EventsSubscription: client.EventsSubscription(),
+		Events: []kicksubscription.Event{
+			{Type: kickwebhookenum.ChatMessageSent},
+			{Type: kickwebhookenum.LivestreamStatusUpdated},
+		},
+		CreateInterval: time.Millisecond,
+		VerifyInterval: time.Hour,
+		Logger:         slog.New(slog.DiscardHandler),
+	})
+
+	// Act
+	subscriptions, err := manager.Enroll(t.Context(), "app-token", 1)
+
+	// Assert
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !slices.Equal(created, []string{"livestream.status.updated"}) {
+		t.Fatalf("Expected only the missing event to be created, got %v", created)
+	}
+
+	if len(subscriptions) != 2 || subscriptions[0].ID != "existing" || subscriptions[1].ID != "created-livestream.status.updated" {
+		t.Fatalf("Expected the existing subscription to be adopted, got %+v", subscriptions)
+	}
+}
+
+func Test_ManagerEnrollPartialFailure_Error(t *testing.T) {
+	// Arrange
+	createJSON := `{
+		"data": [
+			{"name": "chat.message.sent", "version": 1, "subscription_id": "created-1"},
+			{"name": "livestream.status.updated", "version": 1, "error": "not allowed"}
+		],
+		"message": "OK"
+	}`
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if req.Method == http.MethodPost {
+				return mocks.NewMockResponse(http.StatusOK, createJSON), nil
+			}
+			return mocks.NewMockResponse(http.StatusOK, `{"data": [], "message": "OK"}`), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	manager, _ := kicksubscription.NewManager(kicksubscription.ManagerConfig{
+		EventsSubscription: client.EventsSubscription(),
+		Events: []kicksubscription.Event{
+			{Type: kickwebhookenum.ChatMessageSent},
+			{Type: kickwebhookenum.LivestreamStatusUpdated},
+		},
+		CreateInterval: time.Millisecond,
+		VerifyInterval: time.Hour,
+		Logger:         slog.New(slog.DiscardHandler),
+	})
+
+	// Act
+	subscriptions, err := manager.Enroll(t.Context(), "app-token", 1)
+
+	// Assert
+	if kickerrors.IsReconcileError(err) == nil {
+		t.Fatalf("Expected reconcile error, got %v", err)
+	}
+
+	if len(subscriptions) != 1 || subscriptions[0].Event != kickwebhookenum.ChatMessageSent {
+		t.Fatalf("Expected the accepted subscription to be stored, got %+v", subscriptions)
+	}
+}
+
+func Test_ManagerUnenroll_Success(t *testing.T) {
+	// Arrange
+	var subscriptions []kickapitypes.EventSubscriptionData
+	creates := 0
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			switch req.Method {
+			case http.MethodPost:
+				creates++
+				var request kickapitypes.CreateEventSubscriptionRequest
+				_ = json.NewDecoder(req.Body).Decode(&request)
+
+				var response kickapitypes.CreateEventSubscriptionsResponse
+				for _, event := range request.Events {
+					id := fmt.Sprintf("created-%d", creates*10+len(response.Data))
+					subscriptions = append(subscriptions, kickapitypes.EventSubscriptionData{BroadcasterUserID: *request.BroadcasterUserID, Event: event.Name, ID: id, Method: "webhook", Version: event.Version})
+					response.Data = append(response.Data, kickapitypes.CreateEventSubscriptionData{Name: event.Name, Version: event.Version, SubscriptionID: id})
+				}
+				body, _ := json.Marshal(response)
+				return mocks.NewMockResponse(http.StatusOK, string(body)), nil
+			case http.MethodDelete:
+				ids := req.URL.Query()["id"]
+				subscriptions = slices.DeleteFunc(subscriptions, func(data kickapitypes.EventSubscriptionData) bool {
+					return slices.Contains(ids, data.ID)
+				})
+				return mocks.NewMockResponse(http.StatusNoContent, ""), nil
+			}
+			body, _ := json.Marshal(kickapitypes.EventSubscription{Data: subscriptions, Message: "OK"})
+			return mocks.NewMockResponse(http.StatusOK, string(body)), nil
+		},
+	}
+	store := kicksubscription.NewMemoryStore()
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	manager, _ := kicksubscription.NewManager(kicksubscription.ManagerConfig{
+		EventsSubscription: client.EventsSubscription(),
+		Events: []kicksubscription.Event{
+			{Type: kickwebhookenum.ChatMessageSent},
+			{Type: kickwebhookenum.LivestreamStatusUpdated},
+		},
+		Store:          store,
+		CreateInterval: time.Millisecond,
+		VerifyInterval: time.Hour,
+		Logger:         slog.New(slog.DiscardHandler),
+	})
+	if _, err := manager.Enroll(t.Context(), "app-token", 1); err != nil {
+		t.Fatal(err)
+	}
+
+	// Act
+	err := manager.Unenroll(t.Context(), "app-token", 1)
+
+	// Assert
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(subscriptions) != 0 {
+		t.Fatalf("Expected subscriptions to be deleted, got %+v", subscriptions)
+	}
+
+	if _, enrolled, _ := store.Load(t.Context(), 1); enrolled {
+		t.Fatal("Expected broadcaster to be removed from the store")
+	}
+}
+
+func Test_ManagerVerifyRepairsDrift_Success(t *testing.T) {
+	// Arrange
+	var subscriptions []kickapitypes.EventSubscriptionData
+	creates := 0
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			switch req.Method {
+			case http.MethodPost:
+				creates++
+				var request kickapitypes.CreateEventSubscriptionRequest
+				_ = json.NewDecoder(req.Body).Decode(&request)
+
+				var response kickapitypes.CreateEventSubscriptionsResponse
+				for _, event := range request.Events {
+					id := fmt.Sprintf("created-%d", creates*10+len(response.Data))
+					subscriptions = append(subscriptions, kickapitypes.EventSubscriptionData{BroadcasterUserID: *request.BroadcasterUserID, Event: event.Name, ID: id, Method: "webhook", Version: event.Version})
+					response.Data = append(response.Data, kickapitypes.CreateEventSubscriptionData{Name: event.Name, Version: event.Version, SubscriptionID: id})
+				}
+				body, _ := json.Marshal(response)
+				return mocks.NewMockResponse(http.StatusOK, string(body)), nil
+			case http.MethodDelete:
+				ids := req.URL.Query()["id"]
+				subscriptions = slices.DeleteFunc(subscriptions, func(data kickapitypes.EventSubscriptionData) bool {
+					return slices.Contains(ids, data.ID)
+				})
+				return mocks.NewMockResponse(http.StatusNoContent, ""), nil
+			}
+			body, _ := json.Marshal(kickapitypes.EventSubscription{Data: subscriptions, Message: "OK"})
+			return mocks.NewMockResponse(http.StatusOK, string(body)), nil
+		},
+	}
+	store := kicksubscription.NewMemoryStore()
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	manager, _ := kicksubscription.NewManager(kicksubscription.ManagerConfig{
+		EventsSubscription: client.EventsSubscription(),
+		Events: []kicksubscription.Event{
+			{Type: kickwebhookenum.ChatMessageSent},
+			{Type: kickwebhookenum.LivestreamStatusUpdated},
+		},
+		Store:          store,
+		CreateInterval: time.Millisecond,
+		VerifyInterval: time.Hour,
+		Logger:         slog.New(slog.DiscardHandler),
+	})
+	if _, err := manager.Enroll(t.Context(), "app-token", 1); err != nil {
+		t.Fatal(err)
+	}
+
+	// A subscription disappeared and an unknown one appeared for the enrolled broadcaster,
+	// while another broadcaster that is not enrolled has its own subscription.
+	subscriptions = append(subscriptions[1:],
+		kickapitypes.EventSubscriptionData{ID: "unknown", BroadcasterUserID: 1, Event: "kicks.gifted", Version: 1},
+		kickapitypes.EventSubscriptionData{ID: "other", BroadcasterUserID: 2, Event: "kicks.gifted", Version: 1},
+	)
+
+	// Act
+	report, err := manager.Verify(t.Context(), "app-token")
+
+	// Assert
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(report.Created) != 1 || report.Created[0].Event != kickwebhookenum.ChatMessageSent {
+		t.Fatalf("Expected the missing subscription to be recreated, got %+v", report.Created)
+	}
+
+	if len(report.Deleted) != 1 || report.Deleted[0].ID != "unknown" {
+		t.Fatalf("Expected only the unknown subscription of the enrolled broadcaster to be deleted, got %+v", report.Deleted)
+	}
+
+	stored, _, _ := store.Load(t.Context(), 1)
+	if len(stored) != 2 {
+		t.Fatalf("Expected the store to be repaired, got %+v", stored)
+	}
+	for _, subscription := range stored {
+		if subscription.Event == kickwebhookenum.ChatMessageSent && subscription.ID != report.Created[0].ID {
+			t.Fatal("Expected the store to hold the new subscription ID")
+		}
+	}
+}
+
+func Test_ManagerVerifyDoesNotBlockEnrollment_Success(t *testing.T) {
+	// Arrange
+	var (
+		mu      sync.Mutex
+		reads   int
+		deleted []string
+	)
+	started := make(chan struct{})
+	release := make(chan struct{})
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			switch req.Method {
+			case http.MethodPost:
+				var request kickapitypes.CreateEventSubscriptionRequest
+				_ = json.NewDecoder(req.Body).Decode(&request)
+
+				var response kickapitypes.CreateEventSubscriptionsResponse
+				for _, event := range request.Events {
+					id := fmt.Sprintf("%d-%s", *request.BroadcasterUserID, event.Name)
+					response.Data = append(response.Data, kickapitypes.CreateEventSubscriptionData{Name: event.Name, Version: event.Version, SubscriptionID: id})
+				}
+				body, _ := json.Marshal(response)
+				return mocks.NewMockResponse(http.StatusOK, string(body)), nil
+			case http.MethodDelete:
+				mu.Lock()
+				deleted = append(deleted, req.URL.Query()["id"]...)
+				mu.Unlock()
+				return mocks.NewMockResponse(http.StatusNoContent, ""), nil
+			}
+
+			mu.Lock()
+			reads++
+			first := reads == 1
+			mu.Unlock()
+			if first {
+				close(started)
+				<-release
+			}
+			return mocks.NewMockResponse(http.StatusOK, `{"data": [], "message": "OK"}`), nil
+		},
+	}
+	store := kicksubscription.NewMemoryStore()
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	manager, _ := kicksubscription.NewManager(kicksubscription.ManagerConfig{
+		EventsSubscription: client.EventsSubscription(),
+		Events: []kicksubscription.Event{
+			{Type: kickwebhookenum.ChatMessageSent},
+		},
+		Store:          store,
+		CreateInterval: time.Millisecond,
+		VerifyInterval: time.Hour,
+		Logger:         slog.New(slog.DiscardHandler),
+	})
+	if err := store.Save(t.Context(), 1, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	verified := make(chan error, 1)
+	go func() {
+		_, err := manager.Verify(t.Context(), "app-token")
+		verified <- err
+	}()
+	<-started
+
+	// Act
+	unenrollErr := manager.Unenroll(t.Context(), "app-token", 1)
+	_, enrollErr := manager.Enroll(t.Context(), "app-token", 2)
+	close(release)
+	verifyErr := <-verified
+
+	// Assert
+	if unenrollErr != nil || enrollErr != nil || verifyErr != nil {
+		t.Fatalf("Expected no error, got %v, %v and %v", unenrollErr, enrollErr, verifyErr)
+	}
+
+	if _, enrolled, _ := store.Load(t.Context(), 1); enrolled {
+		t.Fatal("Expected Verify to not enroll the unenrolled broadcaster again")
+	}
+
+	if _, enrolled, _ := store.Load(t.Context(), 2); !enrolled {
+		t.Fatal("Expected the broadcaster enrolled during Verify to stay enrolled")
+	}
+
+	if !slices.Equal(deleted, []string{"1-chat.message.sent"}) {
+		t.Fatalf("Expected the subscription created for the unenrolled broadcaster to be deleted, got %v", deleted)
+	}
+}
+
+func Test_ManagerRunStopsOnCancel_Success(t *testing.T) {
+	// Arrange
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return mocks.NewMockResponse(http.StatusOK, `{"data": [], "message": "OK"}`), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	manager, _ := kicksubscription.NewManager(kicksubscription.ManagerConfig{
+		EventsSubscription: client.EventsSubscription(),
+		Events: []kicksubscription.Event{
+			{Type: kickwebhookenum.ChatMessageSent},
+			{Type: kickwebhookenum.LivestreamStatusUpdated},
+		},
+		CreateInterval: time.Millisecond,
+		VerifyInterval: time.Hour,
+		Logger:         slog.New(slog.DiscardHandler),
+	})
+	ctx, cancel := context.WithCancel(t.Context())
+
+	calls := 0
+	accessToken := func(ctx context.Context) (string, error) {
+		calls++
+		cancel()
+		return "app-token", nil
+	}
+
+	// Act
+	err := manager.Run(ctx, accessToken)
+
+	// Assert
+	if err != context.Canceled {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+
+	if calls != 1 {
+		t.Fatalf("Expected an immediate verification, got %d", calls)
+	}
+}
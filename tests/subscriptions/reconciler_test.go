@@ -0,0 +1,228 @@
+package kick_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/henrikah/kick-go-sdk/v2"
+	"github.com/henrikah/kick-go-sdk/v2/enums/kickwebhookenum"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/kicksubscription"
+	"github.com/henrikah/kick-go-sdk/v2/tests/mocks"
+)
+
+func existingSubscriptions() []kickapitypes.EventSubscriptionData {
+	return []kickapitypes.EventSubscriptionData{
+		{ID: "keep", BroadcasterUserID: 1, Event: "chat.message.sent", Version: 1, Method: "webhook"},
+		{ID: "duplicate", BroadcasterUserID: 1, Event: "chat.message.sent", Version: 1, Method: "webhook"},
+		{ID: "stale", BroadcasterUserID: 1, Event: "kicks.gifted", Version: 1, Method: "webhook"},
+		{ID: "unlisted", BroadcasterUserID: 2, Event: "kicks.gifted", Version: 1, Method: "webhook"},
+	}
+}
+
+func desiredSubscriptions() []kicksubscription.Subscription {
+	return []kicksubscription.Subscription{
+		{BroadcasterUserID: 1, Event: kickwebhookenum.ChatMessageSent},
+		{BroadcasterUserID: 1, Event: kickwebhookenum.ChannelFollowed, Version: 1},
+		{BroadcasterUserID: 3, Event: kickwebhookenum.ChannelFollowed, Version: 1},
+	}
+}
+
+func Test_ReconcilerDryRun_Success(t *testing.T) {
+	// Arrange
+	changes := 0
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if req.Method != http.MethodGet {
+				changes++
+			}
+			body, _ := json.Marshal(kickapitypes.EventSubscription{Data: existingSubscriptions(), Message: "OK"})
+			return mocks.NewMockResponse(http.StatusOK, string(body)), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	reconciler, _ := kicksubscription.NewReconciler(kicksubscription.ReconcilerConfig{
+		EventsSubscription: client.EventsSubscription(),
+		DryRun:             true,
+	})
+
+	// Act
+	report, err := reconciler.Reconcile(t.Context(), "access-token", desiredSubscriptions())
+
+	// Assert
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !report.DryRun || len(report.Unchanged) != 1 || report.Unchanged[0].ID != "keep" {
+		t.Fatalf("Expected the oldest subscription to be kept, got %+v", report.Unchanged)
+	}
+
+	if len(report.ToCreate) != 2 || len(report.ToDelete) != 2 {
+		t.Fatalf("Expected 2 creates and 2 deletes, got %d and %d", len(report.ToCreate), len(report.ToDelete))
+	}
+
+	if changes != 0 {
+		t.Fatal("Expected a dry run to not change anything")
+	}
+}
+
+func Test_ReconcilerApply_Success(t *testing.T) {
+	// Arrange
+	subscriptions := existingSubscriptions()
+	creates := 0
+	var deletes [][]string
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			switch req.Method {
+			case http.MethodPost:
+				creates++
+				var request kickapitypes.CreateEventSubscriptionRequest
+				_ = json.NewDecoder(req.Body).Decode(&request)
+
+				var response kickapitypes.CreateEventSubscriptionsResponse
+				for _, event := range request.Events {
+					id := fmt.Sprintf("created-%d", len(subscriptions))
+					subscriptions = append(subscriptions, kickapitypes.EventSubscriptionData{BroadcasterUserID: *request.BroadcasterUserID, Event: event.Name, ID: id, Method: "webhook", Version: event.Version})
+					response.Data = append(response.Data, kickapitypes.CreateEventSubscriptionData{Name: event.Name, Version: event.Version, SubscriptionID: id})
+				}
+				body, _ := json.Marshal(response)
+				return mocks.NewMockResponse(http.StatusOK, string(body)), nil
+			case http.MethodDelete:
+				ids := req.URL.Query()["id"]
+				deletes = append(deletes, ids)
+				subscriptions = slices.DeleteFunc(subscriptions, func(data kickapitypes.EventSubscriptionData) bool {
+					return slices.Contains(ids, data.ID)
+				})
+				return mocks.NewMockResponse(http.StatusNoContent, ""), nil
+			}
+			body, _ := json.Marshal(kickapitypes.EventSubscription{Data: subscriptions, Message: "OK"})
+			return mocks.NewMockResponse(http.StatusOK, string(body)), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	reconciler, _ := kicksubscription.NewReconciler(kicksubscription.ReconcilerConfig{
+		EventsSubscription: client.EventsSubscription(),
+	})
+
+	// Act
+	report, err := reconciler.Reconcile(t.Context(), "access-token", desiredSubscriptions())
+	again, againErr := reconciler.Reconcile(t.Context(), "access-token", desiredSubscriptions())
+
+	// Assert
+	if err != nil || againErr != nil {
+		t.Fatalf("Expected reconciliation to succeed, got %v and %v", err, againErr)
+	}
+
+	if len(report.Created) != 2 || len(report.Deleted) != 2 {
+		t.Fatalf("Expected 2 created and 2 deleted, got %d and %d", len(report.Created), len(report.Deleted))
+	}
+
+	if len(deletes) != 1 || strings.Join(deletes[0], ",") != "duplicate,stale" {
+		t.Fatalf("Expected duplicate and stale to be deleted, got %v", deletes)
+	}
+
+	if creates != 2 {
+		t.Fatalf("Expected one create request per broadcaster, got %d", creates)
+	}
+
+	if len(again.ToCreate) != 0 || len(again.ToDelete) != 0 || len(again.Unchanged) != 3 {
+		t.Fatalf("Expected a second run to have nothing to do, got %+v", again)
+	}
+}
+
+func Test_ReconcilerPruneUnlistedBroadcasters_Success(t *testing.T) {
+	// Arrange
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			body, _ := json.Marshal(kickapitypes.EventSubscription{Data: existingSubscriptions(), Message: "OK"})
+			return mocks.NewMockResponse(http.StatusOK, string(body)), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	reconciler, _ := kicksubscription.NewReconciler(kicksubscription.ReconcilerConfig{
+		EventsSubscription:        client.EventsSubscription(),
+		DryRun:                    true,
+		PruneUnlistedBroadcasters: true,
+	})
+
+	// Act
+	report, err := reconciler.Reconcile(t.Context(), "access-token", desiredSubscriptions())
+
+	// Assert
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(report.ToDelete) != 3 || report.ToDelete[2].ID != "unlisted" {
+		t.Fatalf("Expected the unlisted broadcaster to be pruned, got %+v", report.ToDelete)
+	}
+}
+
+func Test_ReconcilerPartialFailure_Error(t *testing.T) {
+	// Arrange
+	createJSON := `{
+		"data": [
+			{"name": "chat.message.sent", "version": 1, "subscription_id": "created-1"},
+			{"name": "channel.followed", "version": 1, "error": "missing scope"}
+		],
+		"message": "OK"
+	}`
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			if req.Method == http.MethodPost {
+				return mocks.NewMockResponse(http.StatusOK, createJSON), nil
+			}
+			return mocks.NewMockResponse(http.StatusOK, `{"data": [], "message": "OK"}`), nil
+		},
+	}
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: httpClient})
+	reconciler, _ := kicksubscription.NewReconciler(kicksubscription.ReconcilerConfig{
+		EventsSubscription: client.EventsSubscription(),
+	})
+
+	// Act
+	report, err := reconciler.Reconcile(t.Context(), "access-token", []kicksubscription.Subscription{
+		{BroadcasterUserID: 1, Event: kickwebhookenum.ChatMessageSent},
+		{BroadcasterUserID: 1, Event: kickwebhookenum.ChannelFollowed},
+	})
+
+	// Assert
+	reconcileErr := kickerrors.IsReconcileError(err)
+	if reconcileErr == nil {
+		t.Fatalf("Expected reconcile error, got %v", err)
+	}
+
+	if report == nil || len(report.Created) != 1 || report.Created[0].Event != kickwebhookenum.ChatMessageSent {
+		t.Fatalf("Expected the accepted subscription to be reported as created, got %+v", report)
+	}
+
+	if len(reconcileErr.Failed) != 1 || reconcileErr.Failed[0].Reason != "missing scope" || reconcileErr.Failed[0].Action != kicksubscription.ActionCreate {
+		t.Fatalf("Expected the rejected event to fail with its reason, got %+v", reconcileErr.Failed)
+	}
+}
+
+func Test_ReconcilerInvalidBroadcaster_Error(t *testing.T) {
+	// Arrange
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{HTTPClient: http.DefaultClient})
+	reconciler, _ := kicksubscription.NewReconciler(kicksubscription.ReconcilerConfig{
+		EventsSubscription: client.EventsSubscription(),
+	})
+
+	// Act
+	report, err := reconciler.Reconcile(t.Context(), "access-token", []kicksubscription.Subscription{{Event: kickwebhookenum.ChatMessageSent}})
+
+	// Assert
+	if report != nil {
+		t.Fatal("Expected report to be nil")
+	}
+
+	if kickerrors.IsValidationError(err) == nil {
+		t.Fatalf("Expected validation error, got %v", err)
+	}
+}
@@ -0,0 +1,354 @@
+package kick_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/henrikah/kick-go-sdk/v2"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/kicktelemetry"
+	"github.com/henrikah/kick-go-sdk/v2/kickwebhooktypes"
+	"github.com/henrikah/kick-go-sdk/v2/tests/mocks"
+)
+
+type spanContextKey struct{}
+
+type recordingTelemetry struct {
+	requestInfos   []kicktelemetry.RequestInfo
+	requestSpans   []kicktelemetry.RequestResult
+	requestMetrics []kicktelemetry.RequestResult
+	webhookInfos   []kicktelemetry.WebhookInfo
+	webhookSpans   []kicktelemetry.WebhookResult
+	webhookMetrics []kicktelemetry.WebhookResult
+}
+
+type recordingRequestSpan struct {
+	telemetry *recordingTelemetry
+}
+
+func (s recordingRequestSpan) End(result kicktelemetry.RequestResult) {
+	s.telemetry.requestSpans = append(s.telemetry.requestSpans, result)
+}
+
+type recordingWebhookSpan struct {
+	telemetry *recordingTelemetry
+}
+
+func (s recordingWebhookSpan) End(result kicktelemetry.WebhookResult) {
+	s.telemetry.webhookSpans = append(s.telemetry.webhookSpans, result)
+}
+
+func (r *recordingTelemetry) StartRequestSpan(ctx context.Context, info kicktelemetry.RequestInfo) (context.Context, kicktelemetry.RequestSpan) {
+	r.requestInfos = append(r.requestInfos, info)
+	return context.WithValue(ctx, spanContextKey{}, info.Operation), recordingRequestSpan{telemetry: r}
+}
+
+func (r *recordingTelemetry) StartWebhookSpan(ctx context.Context, info kicktelemetry.WebhookInfo) (context.Context, kicktelemetry.WebhookSpan) {
+	r.webhookInfos = append(r.webhookInfos, info)
+	return context.WithValue(ctx, spanContextKey{}, info.MessageID), recordingWebhookSpan{telemetry: r}
+}
+
+func (r *recordingTelemetry) RecordRequest(ctx context.Context, info kicktelemetry.RequestInfo, result kicktelemetry.RequestResult) {
+	r.requestMetrics = append(r.requestMetrics, result)
+}
+
+func (r *recordingTelemetry) RecordWebhook(ctx context.Context, info kicktelemetry.WebhookInfo, result kicktelemetry.WebhookResult) {
+	r.webhookMetrics = append(r.webhookMetrics, result)
+}
+
+func generateKeyPair(t *testing.T) (*rsa.PrivateKey, string) {
+	t.Helper()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKeyBytes, _ := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	return privateKey, string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyBytes}))
+}
+
+func signPayload(t *testing.T, priv *rsa.PrivateKey, messageID string, timestamp string, body []byte) string {
+	t.Helper()
+
+	hashed := sha256.Sum256(fmt.Appendf(nil, "%s.%s.%s", messageID, timestamp, body))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, priv, crypto.SHA256, hashed[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return base64.StdEncoding.EncodeToString(sig)
+}
+
+func Test_APIClientTracesRequest_Success(t *testing.T) {
+	// Arrange
+	telemetry := &recordingTelemetry{}
+	var spanOperation any
+
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			spanOperation = req.Context().Value(spanContextKey{})
+			return mocks.NewMockResponse(http.StatusNoContent, ""), nil
+		},
+	}
+
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{
+		HTTPClient: httpClient,
+		Tracer:     telemetry,
+		Metrics:    telemetry,
+	})
+
+	// Act
+	err := client.Chat().DeleteChatMessage(t.Context(), "access-token", "01J8Z9X7Y6")
+
+	// Assert
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(telemetry.requestInfos) != 1 {
+		t.Fatalf("Expected 1 span, got %d", len(telemetry.requestInfos))
+	}
+
+	info := telemetry.requestInfos[0]
+	if info.Operation != "Chat.DeleteChatMessage" {
+		t.Fatalf("Expected operation Chat.DeleteChatMessage, got %q", info.Operation)
+	}
+
+	if info.Endpoint != "public/v1/chat/{message_id}" {
+		t.Fatalf("Expected endpoint template, got %q", info.Endpoint)
+	}
+
+	if info.Method != http.MethodDelete {
+		t.Fatalf("Expected method DELETE, got %q", info.Method)
+	}
+
+	if spanOperation != "Chat.DeleteChatMessage" {
+		t.Fatal("Expected the HTTP request to carry the span context")
+	}
+
+	if len(telemetry.requestSpans) != 1 || telemetry.requestSpans[0].StatusCode != http.StatusNoContent || telemetry.requestSpans[0].Err != nil {
+		t.Fatalf("Expected span to end with status 204, got %+v", telemetry.requestSpans)
+	}
+
+	if len(telemetry.requestMetrics) != 1 {
+		t.Fatalf("Expected 1 recorded request, got %d", len(telemetry.requestMetrics))
+	}
+}
+
+func Test_APIClientTracesOuterOperation_Success(t *testing.T) {
+	// Arrange
+	telemetry := &recordingTelemetry{}
+
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return mocks.NewMockResponse(http.StatusOK, `{"data": [], "message": "OK"}`), nil
+		},
+	}
+
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{
+		HTTPClient: httpClient,
+		Tracer:     telemetry,
+	})
+
+	// Act
+	_, err := client.Channel().GetChannelByBroadcasterUserID(t.Context(), "access-token", 1)
+
+	// Assert
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(telemetry.requestInfos) != 1 || telemetry.requestInfos[0].Operation != "Channel.GetChannelByBroadcasterUserID" {
+		t.Fatalf("Expected span named after the called method, got %+v", telemetry.requestInfos)
+	}
+
+	if telemetry.requestInfos[0].Endpoint != "public/v1/channels" {
+		t.Fatalf("Expected endpoint without query, got %q", telemetry.requestInfos[0].Endpoint)
+	}
+}
+
+func Test_APIClientRecordsFailedRequest_Success(t *testing.T) {
+	// Arrange
+	telemetry := &recordingTelemetry{}
+
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			return mocks.NewMockResponse(http.StatusTooManyRequests, `{"message": "slow down"}`), nil
+		},
+	}
+
+	client, _ := kick.NewAPIClient(kickapitypes.APIClientConfig{
+		HTTPClient: httpClient,
+		Metrics:    telemetry,
+	})
+
+	// Act
+	_, err := client.Chat().SendChatMessageAsBot(t.Context(), "access-token", nil, "hello")
+
+	// Assert
+	if kickerrors.IsAPIError(err) == nil {
+		t.Fatalf("Expected API error, got %v", err)
+	}
+
+	if len(telemetry.requestInfos) != 0 {
+		t.Fatal("Expected no spans without a tracer")
+	}
+
+	if len(telemetry.requestMetrics) != 1 {
+		t.Fatalf("Expected 1 recorded request, got %d", len(telemetry.requestMetrics))
+	}
+
+	result := telemetry.requestMetrics[0]
+	if result.StatusCode != http.StatusTooManyRequests || result.Err == nil || result.RetryCount != 0 {
+		t.Fatalf("Expected failed request with status 429, got %+v", result)
+	}
+}
+
+func Test_WebhookClientTracesVerifiedWebhook_Success(t *testing.T) {
+	// Arrange
+	telemetry := &recordingTelemetry{}
+	privateKey, publicKey := generateKeyPair(t)
+
+	client, err := kick.NewWebhookClientWithConfig(kickwebhooktypes.WebhookClientConfig{
+		PublicKey: publicKey,
+		Tracer:    telemetry,
+		Metrics:   telemetry,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var handlerSpan any
+	_ = client.RegisterChannelFollowedHandler(func(w http.ResponseWriter, r *http.Request, h kickwebhooktypes.KickWebhookHeaders, data kickwebhooktypes.ChannelFollowed) {
+		handlerSpan = r.Context().Value(spanContextKey{})
+		w.WriteHeader(http.StatusOK)
+	})
+
+	timestamp := "2025-01-14T16:08:06Z"
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{}`))
+	request.Header.Set("Kick-Event-Message-Id", "message-1")
+	request.Header.Set("Kick-Event-Message-Timestamp", timestamp)
+	request.Header.Set("Kick-Event-Type", "channel.followed")
+	request.Header.Set("Kick-Event-Version", "1")
+	request.Header.Set("Kick-Event-Signature", signPayload(t, privateKey, "message-1", timestamp, []byte(`{}`)))
+
+	// Act
+	recorder := httptest.NewRecorder()
+	client.WebhookHandler(recorder, request)
+
+	// Assert
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d", http.StatusOK, recorder.Code)
+	}
+
+	if len(telemetry.webhookInfos) != 1 || telemetry.webhookInfos[0].EventType != "channel.followed" || telemetry.webhookInfos[0].MessageID != "message-1" {
+		t.Fatalf("Expected webhook span with event type and message ID, got %+v", telemetry.webhookInfos)
+	}
+
+	if handlerSpan != "message-1" {
+		t.Fatal("Expected the handler to receive the span context")
+	}
+
+	if len(telemetry.webhookSpans) != 1 || !telemetry.webhookSpans[0].Verified || telemetry.webhookSpans[0].Err != nil {
+		t.Fatalf("Expected verified webhook span, got %+v", telemetry.webhookSpans)
+	}
+
+	if len(telemetry.webhookMetrics) != 1 {
+		t.Fatalf("Expected 1 recorded webhook, got %d", len(telemetry.webhookMetrics))
+	}
+}
+
+func Test_WebhookClientTracesInvalidSignature_Error(t *testing.T) {
+	// Arrange
+	telemetry := &recordingTelemetry{}
+	_, publicKey := generateKeyPair(t)
+	otherKey, _ := generateKeyPair(t)
+
+	client, err := kick.NewWebhookClientWithConfig(kickwebhooktypes.WebhookClientConfig{
+		PublicKey: publicKey,
+		OnError:   func(error) {},
+		Metrics:   telemetry,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	passthrough := client.WebhookPassthroughHandler(func(w http.ResponseWriter, r *http.Request, h kickwebhooktypes.KickWebhookHeaders) {
+		t.Error("Expected handler to not be called")
+	})
+
+	timestamp := "2025-01-14T16:08:06Z"
+	request := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{}`))
+	request.Header.Set("Kick-Event-Message-Id", "message-2")
+	request.Header.Set("Kick-Event-Message-Timestamp", timestamp)
+	request.Header.Set("Kick-Event-Type", "chat.message.sent")
+	request.Header.Set("Kick-Event-Version", "1")
+	request.Header.Set("Kick-Event-Signature", signPayload(t, otherKey, "message-2", timestamp, []byte(`{}`)))
+
+	// Act
+	recorder := httptest.NewRecorder()
+	passthrough(recorder, request)
+
+	// Assert
+	if recorder.Code != http.StatusUnauthorized {
+		t.Fatalf("Expected status %d, got %d", http.StatusUnauthorized, recorder.Code)
+	}
+
+	if len(telemetry.webhookMetrics) != 1 {
+		t.Fatalf("Expected 1 recorded webhook, got %d", len(telemetry.webhookMetrics))
+	}
+
+	result := telemetry.webhookMetrics[0]
+	if result.Verified || kickerrors.IsInternalWebookError(result.Err) == nil {
+		t.Fatalf("Expected unverified webhook with error, got %+v", result)
+	}
+}
+
+func Test_WebhookClientRecordsUnreadableBodyOnce_Error(t *testing.T) {
+	// Arrange
+	telemetry := &recordingTelemetry{}
+	_, publicKey := generateKeyPair(t)
+
+	client, err := kick.NewWebhookClientWithConfig(kickwebhooktypes.WebhookClientConfig{
+		PublicKey: publicKey,
+		OnError:   func(error) {},
+		Tracer:    telemetry,
+		Metrics:   telemetry,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	passthrough := client.WebhookPassthroughHandler(func(w http.ResponseWriter, r *http.Request, h kickwebhooktypes.KickWebhookHeaders) {
+		t.Error("Expected handler to not be called")
+	})
+
+	request := httptest.NewRequest(http.MethodPost, "/", iotest.ErrReader(errors.New("connection reset")))
+	request.Header.Set("Kick-Event-Message-Id", "message-3")
+	request.Header.Set("Kick-Event-Type", "chat.message.sent")
+
+	// Act
+	passthrough(httptest.NewRecorder(), request)
+
+	// Assert
+	if len(telemetry.webhookSpans) != 1 || len(telemetry.webhookMetrics) != 1 {
+		t.Fatalf("Expected 1 span and 1 recorded webhook, got %d and %d", len(telemetry.webhookSpans), len(telemetry.webhookMetrics))
+	}
+
+	if result := telemetry.webhookMetrics[0]; result.Verified || !strings.Contains(result.Err.Error(), "connection reset") {
+		t.Fatalf("Expected the read error to be recorded, got %+v", result)
+	}
+}
@@ -0,0 +1,87 @@
+package kick_test
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/henrikah/kick-go-sdk/v2"
+	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
+	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/tests/mocks"
+)
+
+func Test_GetAllUsersByIDMissingIDs_Error(t *testing.T) {
+	// Arrange
+	config := kickapitypes.APIClientConfig{
+		HTTPClient: http.DefaultClient,
+	}
+	client, _ := kick.NewAPIClient(config)
+
+	// Act
+	usersData, err := client.User().GetAllUsersByID(t.Context(), "access-token", nil)
+
+	// Assert
+	if usersData != nil {
+		t.Fatal("Expected usersData to be nil")
+	}
+
+	validationErr := kickerrors.IsValidationError(err)
+	if validationErr == nil {
+		t.Fatalf("Expected validation error, got %T", err)
+	}
+
+	if validationErr.Field != "userIDs" {
+		t.Fatalf("Expected error on field 'userIDs', got '%s'", validationErr.Field)
+	}
+}
+
+func Test_GetAllUsersByID_Success(t *testing.T) {
+	// Arrange
+	userIDs := make([]int64, 101)
+	for i := range userIDs {
+		userIDs[i] = int64(1000 + i)
+	}
+
+	httpClient := &mocks.MockHTTPClient{
+		DoFunc: func(req *http.Request) (*http.Response, error) {
+			ids := req.URL.Query()["id"]
+			if len(ids) > 50 {
+				t.Errorf("Expected at most 50 IDs per request, got %d", len(ids))
+			}
+			entries := make([]string, len(ids))
+			for i, id := range ids {
+				entries[i] = fmt.Sprintf(`{"user_id": %s}`, id)
+			}
+			return mocks.NewMockResponse(http.StatusOK, fmt.Sprintf(`{"data": [%s], "message": "OK"}`, strings.Join(entries, ","))), nil
+		},
+	}
+
+	config := kickapitypes.APIClientConfig{
+		HTTPClient: httpClient,
+	}
+	client, _ := kick.NewAPIClient(config)
+
+	// Act
+	usersData, err := client.User().GetAllUsersByID(t.Context(), "access-token", userIDs)
+
+	// Assert
+	if err != nil {
+		t.Fatalf("Expected error to be nil, got %v", err)
+	}
+
+	if len(usersData.Data) != len(userIDs) {
+		t.Fatalf("Expected %d users, got %d", len(userIDs), len(usersData.Data))
+	}
+
+	for i, user := range usersData.Data {
+		if int64(user.UserID) != userIDs[i] {
+			t.Fatalf("Expected user %d to be %d, got %d", i, userIDs[i], user.UserID)
+		}
+	}
+
+	if usersData.Message != "OK" {
+		t.Fatalf("Expected Message to be OK, got %s", usersData.Message)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"net/url"
 	"strconv"
 
+	"github.com/henrikah/kick-go-sdk/v2/internal/batch"
 	"github.com/henrikah/kick-go-sdk/v2/internal/endpoints"
 	"github.com/henrikah/kick-go-sdk/v2/kickapitypes"
 	"github.com/henrikah/kick-go-sdk/v2/kickcontracts"
@@ -23,6 +24,8 @@ func newUserService(client *apiClient) kickcontracts.User {
 }
 
 func (c *userClient) GetUsersByID(ctx context.Context, accessToken string, userIDs []int64) (*kickapitypes.Users, error) {
+	ctx = c.client.requester.WithOperation(ctx, "User.GetUsersByID")
+
 	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
 		return nil, err
 	}
@@ -48,9 +51,34 @@ func (c *userClient) GetUsersByID(ctx context.Context, accessToken string, userI
 }
 
 func (c *userClient) GetUserByID(ctx context.Context, accessToken string, userID int64) (*kickapitypes.Users, error) {
+	ctx = c.client.requester.WithOperation(ctx, "User.GetUserByID")
+
 	return c.GetUsersByID(ctx, accessToken, []int64{userID})
 }
 
 func (c *userClient) GetCurrentUser(ctx context.Context, accessToken string) (*kickapitypes.Users, error) {
+	ctx = c.client.requester.WithOperation(ctx, "User.GetCurrentUser")
+
 	return c.GetUsersByID(ctx, accessToken, nil)
 }
+
+func (c *userClient) GetAllUsersByID(ctx context.Context, accessToken string, userIDs []int64) (*kickapitypes.Users, error) {
+	ctx = c.client.requester.WithOperation(ctx, "User.GetAllUsersByID")
+
+	if err := kickerrors.ValidateAccessToken(accessToken); err != nil {
+		return nil, err
+	}
+	if err := kickerrors.ValidateMinItems("userIDs", userIDs, 1); err != nil {
+		return nil, err
+	}
+
+	results, err := batch.Run(ctx, userIDs, 50, c.client.batchConcurrency, func(ctx context.Context, chunk []int64) (*kickapitypes.Users, error) {
+		return c.GetUsersByID(ctx, accessToken, chunk)
+	})
+
+	message, data := batch.Merge(results, func(result *kickapitypes.Users) (string, []kickapitypes.UserData) {
+		return result.Message, result.Data
+	})
+
+	return &kickapitypes.Users{Message: message, Data: data}, err
+}
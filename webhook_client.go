@@ -2,6 +2,7 @@ package kick
 
 import (
 	"bytes"
+	"context"
 	"crypto"
 	"crypto/rsa"
 	"crypto/sha256"
@@ -11,10 +12,14 @@ import (
 	"encoding/pem"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/henrikah/kick-go-sdk/v2/enums/kickwebhookenum"
+	"github.com/henrikah/kick-go-sdk/v2/internal/logging"
 	"github.com/henrikah/kick-go-sdk/v2/kickerrors"
+	"github.com/henrikah/kick-go-sdk/v2/kicktelemetry"
 	"github.com/henrikah/kick-go-sdk/v2/kickwebhooktypes"
 )
 
@@ -88,9 +93,16 @@ type webhook interface {
 }
 
 type webhookClient struct {
-	onError   func(error)
+	onError func(error)
+
+	// customOnError is true when onError was configured, the default callback only logs.
+	customOnError bool
+
 	handlers  map[kickwebhookenum.WebhookType]func(http.ResponseWriter, *http.Request, kickwebhooktypes.KickWebhookHeaders)
+	logger    *slog.Logger
 	publicKey *rsa.PublicKey
+	tracer    kicktelemetry.Tracer
+	metrics   kicktelemetry.MetricsRecorder
 }
 
 // NewWebhookClient creates a new WebhookClient instance using the provided public key.
@@ -120,14 +132,36 @@ type webhookClient struct {
 //
 //	http.HandleFunc("/", webhookClient.WebhookHandler)
 func NewWebhookClient(publicKey string, onError ...func(error)) (webhook, error) {
-	if publicKey == "" {
+	config := kickwebhooktypes.WebhookClientConfig{
+		PublicKey: publicKey,
+	}
+	if len(onError) > 0 {
+		config.OnError = onError[0]
+	}
+
+	return NewWebhookClientWithConfig(config)
+}
+
+// NewWebhookClientWithConfig creates a new WebhookClient instance with the provided configuration.
+//
+// Example:
+//
+//	webhookClient, err := kick.NewWebhookClientWithConfig(kickwebhooktypes.WebhookClientConfig{
+//	    PublicKey: "your-public-key",
+//	    Logger:    slog.New(slog.NewJSONHandler(os.Stdout, nil)),
+//	})
+//	if err != nil {
+//	    log.Fatalf("could not create WebhookClient: %v", err)
+//	}
+func NewWebhookClientWithConfig(config kickwebhooktypes.WebhookClientConfig) (webhook, error) {
+	if config.PublicKey == "" {
 		return nil, &kickerrors.ValidationError{
 			Field:   "publicKey",
 			Message: "cannot be empty",
 		}
 	}
 
-	decodePublicKey, _ := pem.Decode([]byte(publicKey))
+	decodePublicKey, _ := pem.Decode([]byte(config.PublicKey))
 	if decodePublicKey == nil {
 		return nil, fmt.Errorf("failed to parse PEM block containing the public key")
 	}
@@ -140,17 +174,23 @@ func NewWebhookClient(publicKey string, onError ...func(error)) (webhook, error)
 		return nil, fmt.Errorf("not an RSA public key")
 	}
 
+	logger := logging.OrDefault(config.Logger)
+
 	errorCB := func(err error) {
-		fmt.Print(err)
+		logger.Error("kick: webhook error", slog.Any("error", err))
 	}
-	if len(onError) > 0 {
-		errorCB = onError[0]
+	if config.OnError != nil {
+		errorCB = config.OnError
 	}
 
 	return &webhookClient{
-		handlers:  make(map[kickwebhookenum.WebhookType]func(http.ResponseWriter, *http.Request, kickwebhooktypes.KickWebhookHeaders)),
-		onError:   errorCB,
-		publicKey: publicKeyAsserted,
+		handlers:      make(map[kickwebhookenum.WebhookType]func(http.ResponseWriter, *http.Request, kickwebhooktypes.KickWebhookHeaders)),
+		onError:       errorCB,
+		customOnError: config.OnError != nil,
+		logger:        logger,
+		publicKey:     publicKeyAsserted,
+		tracer:        config.Tracer,
+		metrics:       config.Metrics,
 	}, nil
 }
 
@@ -312,6 +352,7 @@ func (c *webhookClient) WebhookHandler(writer http.ResponseWriter, request *http
 	}
 
 	kickHeaders := processKickHeaders(request)
+	request, finish := c.startWebhookTelemetry(request, kickHeaders)
 
 	var err error
 
@@ -319,26 +360,35 @@ func (c *webhookClient) WebhookHandler(writer http.ResponseWriter, request *http
 
 	handler, ok := c.handlers[eventType]
 	if !ok || handler == nil {
-		c.onError(kickerrors.SetInternalWebhookError(kickHeaders.MessageID, kickerrors.SetWebhookHandlerError(kickHeaders.Type, "not found")))
+		err = kickerrors.SetInternalWebhookError(kickHeaders.MessageID, kickerrors.SetWebhookHandlerError(kickHeaders.Type, "not found"))
+		c.warn(request.Context(), "kick: no webhook handler registered", kickHeaders, err)
 		writer.WriteHeader(http.StatusBadRequest)
+		finish(false, err)
 		return
 	}
 
 	body, err := io.ReadAll(request.Body)
 	if err != nil {
-		c.onError(kickerrors.SetInternalWebhookError(kickHeaders.MessageID, err))
+		err = kickerrors.SetInternalWebhookError(kickHeaders.MessageID, err)
+		c.onError(err)
 		writer.WriteHeader(http.StatusBadRequest)
+		finish(false, err)
 	}
 	request.Body = io.NopCloser(bytes.NewReader(body))
 
 	err = c.verifySignature(kickHeaders.MessageID, kickHeaders.MessageTimestamp, body, []byte(kickHeaders.Signature))
 	if err != nil {
-		c.onError(kickerrors.SetInternalWebhookError(kickHeaders.MessageID, err))
+		err = kickerrors.SetInternalWebhookError(kickHeaders.MessageID, err)
+		c.warn(request.Context(), "kick: webhook signature verification failed", kickHeaders, err)
 		writer.WriteHeader(http.StatusUnauthorized)
+		finish(false, err)
 		return
 	}
 
+	start := time.Now()
 	handler(writer, request, kickHeaders)
+	c.logger.DebugContext(request.Context(), "kick: webhook dispatched", append(webhookAttrs(kickHeaders), slog.Duration("duration", time.Since(start)))...)
+	finish(true, nil)
 }
 
 func (c *webhookClient) WebhookPassthroughHandler(handler func(http.ResponseWriter, *http.Request, kickwebhooktypes.KickWebhookHeaders)) func(http.ResponseWriter, *http.Request) {
@@ -349,24 +399,100 @@ func (c *webhookClient) WebhookPassthroughHandler(handler func(http.ResponseWrit
 		}
 
 		kickHeaders := processKickHeaders(request)
+		request, finish := c.startWebhookTelemetry(request, kickHeaders)
 
 		var err error
 
 		body, err := io.ReadAll(request.Body)
 		if err != nil {
-			c.onError(kickerrors.SetInternalWebhookError(kickHeaders.MessageID, err))
+			err = kickerrors.SetInternalWebhookError(kickHeaders.MessageID, err)
+			c.onError(err)
 			writer.WriteHeader(http.StatusBadRequest)
+			finish(false, err)
 		}
 		request.Body = io.NopCloser(bytes.NewReader(body))
 
 		err = c.verifySignature(kickHeaders.MessageID, kickHeaders.MessageTimestamp, body, []byte(kickHeaders.Signature))
 		if err != nil {
-			c.onError(kickerrors.SetInternalWebhookError(kickHeaders.MessageID, err))
+			err = kickerrors.SetInternalWebhookError(kickHeaders.MessageID, err)
+			c.warn(request.Context(), "kick: webhook signature verification failed", kickHeaders, err)
 			writer.WriteHeader(http.StatusUnauthorized)
+			finish(false, err)
 			return
 		}
 
+		start := time.Now()
 		handler(writer, request, kickHeaders)
+		c.logger.DebugContext(request.Context(), "kick: webhook passed through", append(webhookAttrs(kickHeaders), slog.Duration("duration", time.Since(start)))...)
+		finish(true, nil)
+	}
+}
+
+// warn logs a rejected webhook and passes the error to a configured error callback. The default callback is
+// skipped, it would log the same webhook a second time.
+func (c *webhookClient) warn(ctx context.Context, message string, kickHeaders kickwebhooktypes.KickWebhookHeaders, err error) {
+	c.logger.WarnContext(ctx, message, append(webhookAttrs(kickHeaders), slog.Any("error", err))...)
+	if c.customOnError {
+		c.onError(err)
+	}
+}
+
+// finishNoTelemetry is returned by startWebhookTelemetry when no tracer or metrics recorder is configured.
+func finishNoTelemetry(bool, error) {}
+
+// startWebhookTelemetry starts the span of an incoming webhook. It returns the request carrying the span context
+// and the function that records the outcome once the webhook is handled or rejected.
+func (c *webhookClient) startWebhookTelemetry(request *http.Request, kickHeaders kickwebhooktypes.KickWebhookHeaders) (*http.Request, func(verified bool, err error)) {
+	if c.tracer == nil && c.metrics == nil {
+		return request, finishNoTelemetry
+	}
+
+	info := kicktelemetry.WebhookInfo{
+		EventType:      kickHeaders.Type,
+		EventVersion:   kickHeaders.Version,
+		MessageID:      kickHeaders.MessageID,
+		SubscriptionID: kickHeaders.SubscriptionID,
+	}
+
+	ctx := request.Context()
+	var span kicktelemetry.WebhookSpan
+	if c.tracer != nil {
+		ctx, span = c.tracer.StartWebhookSpan(ctx, info)
+		request = request.WithContext(ctx)
+	}
+
+	start := time.Now()
+	finished := false
+	return request, func(verified bool, err error) {
+		// A delivery can fail more than once, e.g. an unreadable body also fails verification. Only the first
+		// outcome is recorded.
+		if finished {
+			return
+		}
+		finished = true
+
+		result := kicktelemetry.WebhookResult{
+			Verified: verified,
+			Duration: time.Since(start),
+			Err:      err,
+		}
+		if span != nil {
+			span.End(result)
+		}
+		if c.metrics != nil {
+			c.metrics.RecordWebhook(ctx, info, result)
+		}
+	}
+}
+
+// webhookAttrs returns the log attributes of the webhook headers, the signature is never logged.
+func webhookAttrs(kickHeaders kickwebhooktypes.KickWebhookHeaders) []any {
+	return []any{
+		slog.String("message_id", kickHeaders.MessageID),
+		slog.String("subscription_id", kickHeaders.SubscriptionID),
+		slog.String("type", kickHeaders.Type),
+		slog.String("version", kickHeaders.Version),
+		slog.String("timestamp", kickHeaders.MessageTimestamp),
 	}
 }
 